@@ -0,0 +1,67 @@
+package dx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Schema is the schema of a resource, e.g. `resp.State.Schema`, which API field errors are
+// attached to.
+type Schema interface {
+	TypeAtPath(ctx context.Context, p path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// AddAPIError appends diagnostics for a failed DX API call.
+// Field-level validation errors are attached to the attribute of schema they refer to, so
+// Terraform points at the offending configuration instead of printing the raw API response.
+// Errors for fields that are not an attribute, or when schema is nil, are reported without one.
+func AddAPIError(ctx context.Context, diags *diag.Diagnostics, schema Schema, summary string, err error) {
+	var apiErr *dxapi.APIError
+	if !errors.As(err, &apiErr) || len(apiErr.FieldErrors) == 0 {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	requestIDSuffix := ""
+	if apiErr.RequestID != "" {
+		requestIDSuffix = fmt.Sprintf(" (request ID: %s)", apiErr.RequestID)
+	}
+
+	for _, fieldErr := range apiErr.FieldErrors {
+		detail := fmt.Sprintf("The DX API rejected `%s`: %s%s", fieldErr.Field, fieldErr.Message, requestIDSuffix)
+		if attributePath, ok := fieldToPath(ctx, schema, fieldErr.Field); ok {
+			diags.AddAttributeError(attributePath, summary, detail)
+		} else {
+			diags.AddError(summary, detail)
+		}
+	}
+
+	if apiErr.Message != "" {
+		diags.AddError(summary, apiErr.Message+requestIDSuffix)
+	}
+}
+
+// fieldToPath converts an API field name such as `checks.0.sql` or `checks[0].sql` into the path
+// of the top-level attribute it belongs to. Nested API fields cannot be mapped any deeper,
+// because the API uses list indexes where the Terraform schema uses map keys. ok is false when
+// the schema has no such attribute, e.g. because the API and schema name a field differently.
+func fieldToPath(ctx context.Context, schema Schema, field string) (path.Path, bool) {
+	root, _, _ := strings.Cut(field, ".")
+	root, _, _ = strings.Cut(root, "[")
+	if schema == nil || root == "" {
+		return path.Empty(), false
+	}
+	attributePath := path.Root(root)
+	if _, diags := schema.TypeAtPath(ctx, attributePath); diags.HasError() {
+		return path.Empty(), false
+	}
+	return attributePath, true
+}
@@ -0,0 +1,60 @@
+package dx
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestAddAPIError verifies that API field errors are attached to matching schema attributes,
+// and reported without an attribute otherwise.
+func TestAddAPIError(t *testing.T) {
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":   schema.StringAttribute{Required: true},
+			"checks": schema.MapAttribute{Optional: true, ElementType: types.StringType},
+		},
+	}
+	err := &dxapi.APIError{
+		StatusCode: 422,
+		FieldErrors: []dxapi.FieldError{
+			{Field: "name", Message: "can't be blank"},
+			{Field: "checks[0].sql", Message: "is invalid"},
+			{Field: "owner_team", Message: "does not exist"},
+		},
+	}
+
+	tests := map[string]struct {
+		schema    Schema
+		wantPaths []path.Path
+	}{
+		"with schema":    {schema: resourceSchema, wantPaths: []path.Path{path.Root("name"), path.Root("checks"), path.Empty()}},
+		"without schema": {schema: nil, wantPaths: []path.Path{path.Empty(), path.Empty(), path.Empty()}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			AddAPIError(context.Background(), &diags, tt.schema, "Error creating thing", err)
+
+			if len(diags) != len(tt.wantPaths) {
+				t.Fatalf("Expected %d diagnostics, got %d: %v", len(tt.wantPaths), len(diags), diags)
+			}
+			for i, want := range tt.wantPaths {
+				got := path.Empty()
+				if withPath, ok := diags[i].(diag.DiagnosticWithPath); ok {
+					got = withPath.Path()
+				}
+				if !got.Equal(want) {
+					t.Errorf("Expected diagnostic %d at %q, got %q", i, want, got)
+				}
+			}
+		})
+	}
+}
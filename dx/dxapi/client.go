@@ -1,7 +1,20 @@
 package dxapi
 
 import (
+	"crypto/tls"
 	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	DefaultMaxRetries   = 4
+	DefaultMinRetryWait = 1 * time.Second
+	DefaultMaxRetryWait = 30 * time.Second
+
+	DefaultRequestTimeout = 60 * time.Second
 )
 
 type Client struct {
@@ -9,13 +22,126 @@ type Client struct {
 	token      string
 	httpClient *http.Client
 	version    string
+	retry      RetryConfig
+
+	// transport is the transport of httpClient, kept so options can adjust TLS and connection settings.
+	transport *http.Transport
+	// headers are extra headers sent with every request.
+	headers map[string]string
+	// redactedKeys are the lower case body keys whose values are masked in logs.
+	redactedKeys []string
+
+	// limiter throttles the rate at which requests are sent. Nil means no limit.
+	limiter *rate.Limiter
+	// inFlight is a semaphore capping the number of concurrent requests. Nil means no limit.
+	inFlight chan struct{}
+
+	// entityTypes caches the entity types returned by CachedEntityType.
+	entityTypes entityTypeCache
+	// teams and users cache the listings that FindTeamsByName and FindUsersByEmail search.
+	teams directory[APITeam]
+	users directory[APIUser]
+}
+
+// RetryConfig controls how the client retries requests that fail with a transient error.
+type RetryConfig struct {
+	// MaxRetries is the number of times a request is retried after the initial attempt. Zero disables retries.
+	MaxRetries int
+	// MinWait is the base delay for the exponential backoff between attempts.
+	MinWait time.Duration
+	// MaxWait caps the delay between attempts, including delays requested by a `Retry-After` header.
+	MaxWait time.Duration
+}
+
+// Option configures optional behaviour of a Client.
+type Option func(*Client)
+
+// WithRetry overrides the default retry behaviour of the client.
+func WithRetry(cfg RetryConfig) Option {
+	return func(c *Client) {
+		c.retry = cfg
+	}
+}
+
+// WithRateLimit limits the client to the given number of requests per second, shared by every
+// resource and data source using the client.
+func WithRateLimit(requestsPerSecond int) Option {
+	return func(c *Client) {
+		if requestsPerSecond > 0 {
+			c.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), requestsPerSecond)
+		}
+	}
+}
+
+// WithMaxConcurrency caps the number of requests the client has in flight at once.
+func WithMaxConcurrency(maxConcurrentRequests int) Option {
+	return func(c *Client) {
+		if maxConcurrentRequests > 0 {
+			c.inFlight = make(chan struct{}, maxConcurrentRequests)
+		}
+	}
+}
+
+// WithRequestTimeout limits how long a single attempt of a request may take, including reading
+// the response body. Retries get a fresh timeout.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.httpClient.Timeout = timeout
+	}
+}
+
+// WithHeaders adds headers to every request. They cannot override the headers the client sets
+// itself, such as `Authorization`.
+func WithHeaders(headers map[string]string) Option {
+	return func(c *Client) {
+		c.headers = headers
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used to connect to the DX API, see TLSOptions.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(c *Client) {
+		c.transport.TLSClientConfig = cfg
+	}
 }
 
-func NewClient(baseURL, token, version string) *Client {
-	return &Client{
-		baseURL:    baseURL,
-		token:      token,
-		httpClient: http.DefaultClient,
-		version:    version,
+// WithProxy sends every request through the HTTP(S) proxy at proxyURL instead of the proxy
+// from the `HTTPS_PROXY` environment variables. Credentials in the URL's user info are sent
+// to the proxy with basic authentication.
+func WithProxy(proxyURL *url.URL) Option {
+	return func(c *Client) {
+		if proxyURL != nil {
+			c.transport.Proxy = http.ProxyURL(proxyURL)
+		}
 	}
 }
+
+func NewClient(baseURL, token, version string, opts ...Option) *Client {
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	}
+
+	c := &Client{
+		baseURL: baseURL,
+		token:   token,
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   DefaultRequestTimeout,
+		},
+		transport:    transport,
+		version:      version,
+		redactedKeys: append([]string(nil), DefaultRedactedKeys...),
+		retry: RetryConfig{
+			MaxRetries: DefaultMaxRetries,
+			MinWait:    DefaultMinRetryWait,
+			MaxWait:    DefaultMaxRetryWait,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
@@ -0,0 +1,77 @@
+package dxapi
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const entityResponse = `{"ok": true, "entity": {"identifier": "svc", "type": "service"}}`
+
+func TestWithHeadersCannotOverrideAuthorization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Team"); got != "platform" {
+			t.Errorf("Expected custom header to be sent, got %q", got)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("Expected Authorization header to be preserved, got %q", got)
+		}
+		_, _ = w.Write([]byte(entityResponse))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, "token", "test", WithHeaders(map[string]string{
+		"X-Team":        "platform",
+		"Authorization": "Bearer other",
+	}))
+
+	if _, err := client.GetEntity(context.Background(), "svc"); err != nil {
+		t.Fatalf("GetEntity failed: %s", err)
+	}
+}
+
+func TestWithRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		_, _ = w.Write([]byte(entityResponse))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, "token", "test", WithRequestTimeout(20*time.Millisecond), WithRetry(RetryConfig{}))
+
+	if _, err := client.GetEntity(context.Background(), "svc"); err == nil {
+		t.Error("Expected the request to time out")
+	}
+}
+
+func TestWithTLSConfigTrustsCACertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(entityResponse))
+	}))
+	t.Cleanup(server.Close)
+
+	caCertPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	untrusted := NewClient(server.URL, "token", "test", WithRetry(RetryConfig{}))
+	if _, err := untrusted.GetEntity(context.Background(), "svc"); err == nil {
+		t.Error("Expected the self-signed certificate to be rejected by default")
+	}
+
+	tlsConfig, err := TLSOptions{CACertPEM: string(caCertPEM)}.Config()
+	if err != nil {
+		t.Fatalf("Building TLS config failed: %s", err)
+	}
+	trusted := NewClient(server.URL, "token", "test", WithTLSConfig(tlsConfig))
+	if _, err := trusted.GetEntity(context.Background(), "svc"); err != nil {
+		t.Errorf("Expected the CA certificate to be trusted, got: %s", err)
+	}
+}
+
+func TestTLSOptionsRejectsInvalidCACertificate(t *testing.T) {
+	if _, err := (TLSOptions{CACertPEM: "not a certificate"}).Config(); err == nil {
+		t.Error("Expected an invalid CA certificate to be rejected")
+	}
+}
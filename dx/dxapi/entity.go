@@ -1,11 +1,8 @@
 package dxapi
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
+	"iter"
 	"net/http"
 	"net/url"
 
@@ -46,6 +43,9 @@ type APIDomain struct {
 type APIAlias struct {
 	Identifier         string  `json:"identifier"`
 	InstanceIdentifier *string `json:"instance_identifier,omitempty"`
+	// Name and Url are resolved by DX from the Data Cloud database, and ignored in requests.
+	Name *string `json:"name,omitempty"`
+	Url  *string `json:"url,omitempty"`
 }
 
 // APIEntityResponse is the top-level response from the DX API for entity endpoints.
@@ -62,222 +62,68 @@ type APIEntityResponse struct {
 
 // APIEntitiesListResponse is the top-level response from the DX API for the entities.list endpoint.
 type APIEntitiesListResponse struct {
-	Ok               bool        `json:"ok"`
-	Entities         []APIEntity `json:"entities"`
-	ResponseMetadata struct {
-		NextCursor string `json:"next_cursor"`
-	} `json:"response_metadata"`
+	Ok               bool             `json:"ok"`
+	Entities         []APIEntity      `json:"entities"`
+	ResponseMetadata ResponseMetadata `json:"response_metadata"`
 }
 
 // ListEntitiesOptions contains optional parameters for ListEntities.
 type ListEntitiesOptions struct {
+	PageOptions
+
 	SearchTerm *string // Filter entities by search term.
 }
 
-func (c *Client) ListEntities(ctx context.Context, entityType string, opts *ListEntitiesOptions) ([]APIEntity, error) {
-	tflog.Info(ctx, fmt.Sprintf("Calling ListEntities for type: %s", entityType))
-
-	var allEntities []APIEntity
-	cursor := ""
-
-	for {
-		urlStr := fmt.Sprintf("%s/entities.list?type=%s&limit=50", c.baseURL, url.QueryEscape(entityType))
-		if cursor != "" {
-			urlStr += "&cursor=" + url.QueryEscape(cursor)
-		}
-		if opts != nil && opts.SearchTerm != nil && *opts.SearchTerm != "" {
-			urlStr += "&search_term=" + url.QueryEscape(*opts.SearchTerm)
-		}
-
-		req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
-		if err != nil {
-			return nil, fmt.Errorf("creating request: %w", err)
-		}
-
-		setRequestHeaders(req, c)
-
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			return nil, fmt.Errorf("making HTTP request: %w", err)
+// IterateEntities lazily lists the entities of a type, requesting further pages only as the
+// caller consumes them.
+func (c *Client) IterateEntities(ctx context.Context, entityType string, opts *ListEntitiesOptions) iter.Seq2[APIEntity, error] {
+	query := url.Values{"type": {entityType}}
+	var pageOpts PageOptions
+	if opts != nil {
+		pageOpts = opts.PageOptions
+		if opts.SearchTerm != nil && *opts.SearchTerm != "" {
+			query.Set("search_term", *opts.SearchTerm)
 		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode != http.StatusOK {
-			body, err := io.ReadAll(resp.Body)
-			return nil, fmt.Errorf("unexpected status code: %d, response body: %s, error: %w", resp.StatusCode, string(body), err)
-		}
-
-		var apiResp APIEntitiesListResponse
-		if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-			return nil, fmt.Errorf("decoding API response: %w", err)
-		}
-
-		allEntities = append(allEntities, apiResp.Entities...)
-
-		if apiResp.ResponseMetadata.NextCursor == "" {
-			break
-		}
-		cursor = apiResp.ResponseMetadata.NextCursor
 	}
 
-	tflog.Info(ctx, fmt.Sprintf("ListEntities returned %d entities", len(allEntities)))
-	return allEntities, nil
+	return paginate(ctx, c, "entities.list", query, pageOpts, func(resp *APIEntitiesListResponse) ([]APIEntity, string) {
+		return resp.Entities, resp.ResponseMetadata.NextCursor
+	})
 }
 
-func (c *Client) CreateEntity(ctx context.Context, payload map[string]interface{}) (*APIEntityResponse, error) {
-	tflog.Info(ctx, "Calling CreateEntity")
-
-	body, err := json.MarshalIndent(payload, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshaling payload: %w", err)
-	}
-
-	url := fmt.Sprintf("%s/entities.create", c.baseURL)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
-	}
-
-	setRequestHeaders(req, c)
-
-	tflog.Info(ctx, fmt.Sprintf("Request body:\n%s", string(body)))
-
-	// Make the request
-	resp, err := c.httpClient.Do(req)
+// ListEntities returns every entity of a type, see IterateEntities.
+func (c *Client) ListEntities(ctx context.Context, entityType string, opts *ListEntitiesOptions) ([]APIEntity, error) {
+	entities, err := collect(c.IterateEntities(ctx, entityType, opts))
 	if err != nil {
-		return nil, fmt.Errorf("making HTTP request: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		body, err := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("unexpected status code: %d, response body: %s, error: %w", resp.StatusCode, string(body), err)
-	}
-
-	// Decode the response into the APIEntityResponse struct
-	var apiResp APIEntityResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, fmt.Errorf("decoding API response: %w", err)
-	}
-
-	// Log the API response for debugging
-	if respJson, err := json.MarshalIndent(apiResp, "", "  "); err == nil {
-		tflog.Info(ctx, fmt.Sprintf("API Response from CreateEntity:\n%s", string(respJson)))
-	} else {
-		tflog.Debug(ctx, "Could not marshal API response", map[string]interface{}{
-			"error": err,
-		})
-	}
+	tflog.Debug(ctx, "Listed entities", map[string]interface{}{
+		"type":  entityType,
+		"count": len(entities),
+	})
+	return entities, nil
+}
 
-	// Return the APIEntityResponse object
-	return &apiResp, nil
+func (c *Client) CreateEntity(ctx context.Context, payload map[string]interface{}) (*APIEntityResponse, error) {
+	return do[APIEntityResponse](ctx, c, http.MethodPost, "entities.create", nil, payload)
 }
 
 func (c *Client) GetEntity(ctx context.Context, identifier string) (*APIEntityResponse, error) {
-	urlStr := fmt.Sprintf("%s/entities.info?identifier=%s", c.baseURL, url.QueryEscape(identifier))
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
-	}
-
-	setRequestHeaders(req, c)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("making HTTP request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, err := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("unexpected status code: %d, response body: %s, error: %w", resp.StatusCode, string(body), err)
-	}
-
-	var apiResp APIEntityResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, fmt.Errorf("decoding API response: %w", err)
-	}
-
-	// Log the API response for debugging
-	if respJson, err := json.MarshalIndent(apiResp, "", "  "); err == nil {
-		tflog.Info(ctx, fmt.Sprintf("API Response from GetEntity:\n%s", string(respJson)))
-	} else {
-		tflog.Debug(ctx, "Could not marshal API response", map[string]interface{}{
-			"error": err,
-		})
-	}
-
-	return &apiResp, nil
+	return do[APIEntityResponse](ctx, c, http.MethodGet, "entities.info", url.Values{"identifier": {identifier}}, nil)
 }
 
 func (c *Client) UpdateEntity(ctx context.Context, payload map[string]interface{}) (*APIEntityResponse, error) {
-	tflog.Info(ctx, "Calling UpdateEntity")
-
-	body, err := json.MarshalIndent(payload, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshaling payload: %w", err)
-	}
-
-	url := fmt.Sprintf("%s/entities.update", c.baseURL)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
-	}
-
-	setRequestHeaders(req, c)
-
-	tflog.Info(ctx, fmt.Sprintf("Request body:\n%s", string(body)))
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("making HTTP request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		body, err := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("unexpected status code: %d, response body: %s, error: %w", resp.StatusCode, string(body), err)
-	}
-
-	var apiResp APIEntityResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, fmt.Errorf("decoding API response: %w", err)
-	}
-
-	// Log the API response for debugging
-	if respJson, err := json.MarshalIndent(apiResp, "", "  "); err == nil {
-		tflog.Info(ctx, fmt.Sprintf("API Response from UpdateEntity:\n%s", string(respJson)))
-	} else {
-		tflog.Debug(ctx, "Could not marshal API response", map[string]interface{}{
-			"error": err,
-		})
-	}
-
-	return &apiResp, nil
+	return do[APIEntityResponse](ctx, c, http.MethodPost, "entities.update", nil, payload)
 }
 
 func (c *Client) DeleteEntity(ctx context.Context, identifier string) (bool, error) {
-	tflog.Info(ctx, "Calling DeleteEntity")
-	tflog.Info(ctx, fmt.Sprintf("Deleting entity with identifier: %s", identifier))
-
-	urlStr := fmt.Sprintf("%s/entities.delete?identifier=%s", c.baseURL, url.QueryEscape(identifier))
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, urlStr, nil)
-	if err != nil {
-		return false, fmt.Errorf("creating request: %w", err)
-	}
-
-	setRequestHeaders(req, c)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return false, fmt.Errorf("making HTTP request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		body, err := io.ReadAll(resp.Body)
-		return false, fmt.Errorf("unexpected status code: %d, response body: %s, error: %w", resp.StatusCode, string(body), err)
+	if _, err := do[okResponse](ctx, c, http.MethodPost, "entities.delete", url.Values{"identifier": {identifier}}, nil); err != nil {
+		// The object is already gone, which is what the caller asked for
+		if IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
 	}
-
 	return true, nil
 }
@@ -0,0 +1,90 @@
+package dxapi
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// APIEntityRelationship is a single edge between two entities, typed by a relation definition.
+type APIEntityRelationship struct {
+	RelationIdentifier     string `json:"relation_identifier"`
+	SourceEntityIdentifier string `json:"source_entity_identifier"`
+	TargetEntityIdentifier string `json:"target_entity_identifier"`
+	CreatedAt              string `json:"created_at"`
+}
+
+type APIEntityRelationshipResponse struct {
+	Ok                 bool                  `json:"ok"`
+	EntityRelationship APIEntityRelationship `json:"entity_relationship"`
+}
+
+// APIEntityRelationshipsListResponse is the top-level response from the DX API for the
+// catalog.entityRelationships.list endpoint.
+type APIEntityRelationshipsListResponse struct {
+	Ok                  bool                    `json:"ok"`
+	EntityRelationships []APIEntityRelationship `json:"entity_relationships"`
+	ResponseMetadata    ResponseMetadata        `json:"response_metadata"`
+}
+
+// ListEntityRelationshipsOptions contains optional filters for IterateEntityRelationships.
+type ListEntityRelationshipsOptions struct {
+	PageOptions
+
+	RelationIdentifier     string // Only edges of this relation definition.
+	SourceEntityIdentifier string // Only edges from this entity.
+	TargetEntityIdentifier string // Only edges to this entity.
+}
+
+// IterateEntityRelationships lazily lists entity relationships, requesting further pages only as
+// the caller consumes them.
+func (c *Client) IterateEntityRelationships(ctx context.Context, opts *ListEntityRelationshipsOptions) iter.Seq2[APIEntityRelationship, error] {
+	query := url.Values{}
+	var pageOpts PageOptions
+	if opts != nil {
+		pageOpts = opts.PageOptions
+		for name, value := range map[string]string{
+			"relation_identifier":      opts.RelationIdentifier,
+			"source_entity_identifier": opts.SourceEntityIdentifier,
+			"target_entity_identifier": opts.TargetEntityIdentifier,
+		} {
+			if value != "" {
+				query.Set(name, value)
+			}
+		}
+	}
+
+	return paginate(ctx, c, "catalog.entityRelationships.list", query, pageOpts, func(resp *APIEntityRelationshipsListResponse) ([]APIEntityRelationship, string) {
+		return resp.EntityRelationships, resp.ResponseMetadata.NextCursor
+	})
+}
+
+func (c *Client) CreateEntityRelationship(ctx context.Context, payload map[string]interface{}) (*APIEntityRelationshipResponse, error) {
+	return do[APIEntityRelationshipResponse](ctx, c, http.MethodPost, "catalog.entityRelationships.create", nil, payload)
+}
+
+func (c *Client) GetEntityRelationship(ctx context.Context, relationIdentifier, sourceEntityIdentifier, targetEntityIdentifier string) (*APIEntityRelationshipResponse, error) {
+	query := url.Values{
+		"relation_identifier":      {relationIdentifier},
+		"source_entity_identifier": {sourceEntityIdentifier},
+		"target_entity_identifier": {targetEntityIdentifier},
+	}
+	return do[APIEntityRelationshipResponse](ctx, c, http.MethodGet, "catalog.entityRelationships.info", query, nil)
+}
+
+func (c *Client) DeleteEntityRelationship(ctx context.Context, relationIdentifier, sourceEntityIdentifier, targetEntityIdentifier string) (bool, error) {
+	payload := map[string]interface{}{
+		"relation_identifier":      relationIdentifier,
+		"source_entity_identifier": sourceEntityIdentifier,
+		"target_entity_identifier": targetEntityIdentifier,
+	}
+	if _, err := do[okResponse](ctx, c, http.MethodPost, "catalog.entityRelationships.delete", nil, payload); err != nil {
+		// The relationship is already gone, which is what the caller asked for
+		if IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -1,12 +1,10 @@
 package dxapi
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
+	"iter"
 	"net/http"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -59,175 +57,67 @@ type APIEntityTypeResponse struct {
 	EntityType APIEntityType `json:"entity_type"`
 }
 
-func (c *Client) CreateEntityType(ctx context.Context, payload map[string]interface{}) (*APIEntityTypeResponse, error) {
-	tflog.Info(ctx, "Calling CreateEntityType")
-
-	body, err := json.MarshalIndent(payload, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshaling payload: %w", err)
-	}
+// APIEntityTypesListResponse is the top-level response from the DX API for the entityTypes.list endpoint.
+type APIEntityTypesListResponse struct {
+	Ok               bool             `json:"ok"`
+	EntityTypes      []APIEntityType  `json:"entity_types"`
+	ResponseMetadata ResponseMetadata `json:"response_metadata"`
+}
 
-	url := fmt.Sprintf("%s/entityTypes.create", c.baseURL)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
+// IterateEntityTypes lazily lists every entity type, requesting further pages only as the caller
+// consumes them.
+func (c *Client) IterateEntityTypes(ctx context.Context, opts *PageOptions) iter.Seq2[APIEntityType, error] {
+	var pageOpts PageOptions
+	if opts != nil {
+		pageOpts = *opts
 	}
 
-	setRequestHeaders(req, c)
-
-	tflog.Info(ctx, fmt.Sprintf("Request body:\n%s", string(body)))
+	return paginate(ctx, c, "entityTypes.list", url.Values{}, pageOpts, func(resp *APIEntityTypesListResponse) ([]APIEntityType, string) {
+		return resp.EntityTypes, resp.ResponseMetadata.NextCursor
+	})
+}
 
-	// Make the request
-	resp, err := c.httpClient.Do(req)
+// ListEntityTypes returns every entity type, see IterateEntityTypes.
+func (c *Client) ListEntityTypes(ctx context.Context) ([]APIEntityType, error) {
+	entityTypes, err := collect(c.IterateEntityTypes(ctx, nil))
 	if err != nil {
-		return nil, fmt.Errorf("making HTTP request: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("unexpected status code: %d, response body: %s", resp.StatusCode, string(body))
-	}
-
-	// Decode the response into the APIEntityTypeResponse struct
-	var apiResp APIEntityTypeResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, fmt.Errorf("decoding API response: %w", err)
-	}
+	tflog.Debug(ctx, "Listed entity types", map[string]interface{}{
+		"count": len(entityTypes),
+	})
+	return entityTypes, nil
+}
 
-	// Log the API response for debugging
-	if respJson, err := json.MarshalIndent(apiResp, "", "  "); err == nil {
-		tflog.Info(ctx, fmt.Sprintf("API Response from CreateEntityType:\n%s", string(respJson)))
-	} else {
-		tflog.Debug(ctx, "Could not marshal API response", map[string]interface{}{
-			"error": err,
-		})
+func (c *Client) CreateEntityType(ctx context.Context, payload map[string]interface{}) (*APIEntityTypeResponse, error) {
+	if identifier, ok := payload["identifier"].(string); ok {
+		defer c.forgetEntityType(identifier, nil)
 	}
-
-	// Return the APIEntityTypeResponse object
-	return &apiResp, nil
+	return do[APIEntityTypeResponse](ctx, c, http.MethodPost, "entityTypes.create", nil, payload)
 }
 
 func (c *Client) GetEntityType(ctx context.Context, identifier string) (*APIEntityTypeResponse, error) {
-	url := fmt.Sprintf("%s/entityTypes.info?identifier=%s", c.baseURL, identifier)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
-	}
-
-	setRequestHeaders(req, c)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("making HTTP request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("unexpected status code: %d, response body: %s", resp.StatusCode, string(body))
-	}
-
-	var apiResp APIEntityTypeResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, fmt.Errorf("decoding API response: %w", err)
-	}
-
-	// Log the API response for debugging
-	if respJson, err := json.MarshalIndent(apiResp, "", "  "); err == nil {
-		tflog.Info(ctx, fmt.Sprintf("API Response from GetEntityType:\n%s", string(respJson)))
-	} else {
-		tflog.Debug(ctx, "Could not marshal API response", map[string]interface{}{
-			"error": err,
-		})
-	}
-
-	return &apiResp, nil
+	return do[APIEntityTypeResponse](ctx, c, http.MethodGet, "entityTypes.info", url.Values{"identifier": {identifier}}, nil)
 }
 
 func (c *Client) UpdateEntityType(ctx context.Context, payload map[string]interface{}) (*APIEntityTypeResponse, error) {
-	tflog.Info(ctx, "Calling UpdateEntityType")
-
-	body, err := json.MarshalIndent(payload, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshaling payload: %w", err)
+	if identifier, ok := payload["identifier"].(string); ok {
+		defer c.forgetEntityType(identifier, nil)
 	}
-
-	url := fmt.Sprintf("%s/entityTypes.update", c.baseURL)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
-	}
-
-	setRequestHeaders(req, c)
-
-	tflog.Info(ctx, fmt.Sprintf("Request body:\n%s", string(body)))
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("making HTTP request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-
-		// format the JSON nicely
-		var prettyJSON bytes.Buffer
-		if err := json.Indent(&prettyJSON, body, "", "  "); err == nil {
-			tflog.Debug(ctx, fmt.Sprintf("Error response body:\n%s", prettyJSON.String()))
-		} else {
-			tflog.Debug(ctx, fmt.Sprintf("Error response body (raw):\n%s", string(body)))
-		}
-
-		return nil, fmt.Errorf("unexpected status code: %d, response body: %s", resp.StatusCode, string(body))
-	}
-
-	var apiResp APIEntityTypeResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, fmt.Errorf("decoding API response: %w", err)
-	}
-
-	// Log the API response for debugging
-	if respJson, err := json.MarshalIndent(apiResp, "", "  "); err == nil {
-		tflog.Info(ctx, fmt.Sprintf("API Response from UpdateEntityType:\n%s", string(respJson)))
-	} else {
-		tflog.Debug(ctx, "Could not marshal API response", map[string]interface{}{
-			"error": err,
-		})
-	}
-
-	return &apiResp, nil
+	return do[APIEntityTypeResponse](ctx, c, http.MethodPost, "entityTypes.update", nil, payload)
 }
 
 func (c *Client) DeleteEntityType(ctx context.Context, identifier string) (bool, error) {
-	tflog.Info(ctx, "Calling DeleteEntityType")
-	tflog.Info(ctx, fmt.Sprintf("Deleting entity type with identifier: %s", identifier))
+	defer c.forgetEntityType(identifier, nil)
 
 	payload := map[string]interface{}{"identifier": identifier}
-	body, err := json.Marshal(payload)
-	if err != nil {
-		return false, fmt.Errorf("marshaling payload: %w", err)
-	}
-
-	url := fmt.Sprintf("%s/entityTypes.delete", c.baseURL)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
-	if err != nil {
-		return false, fmt.Errorf("creating request: %w", err)
-	}
-
-	setRequestHeaders(req, c)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return false, fmt.Errorf("making HTTP request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return false, fmt.Errorf("unexpected status code: %d, response body: %s", resp.StatusCode, string(body))
+	if _, err := do[okResponse](ctx, c, http.MethodPost, "entityTypes.delete", nil, payload); err != nil {
+		// The object is already gone, which is what the caller asked for
+		if IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
 	}
-
 	return true, nil
 }
@@ -0,0 +1,71 @@
+package dxapi
+
+import (
+	"context"
+	"sync"
+)
+
+// entityTypeCache remembers entity types fetched through CachedEntityType, so validating many
+// entities of the same type costs a single request.
+type entityTypeCache struct {
+	mu      sync.Mutex
+	entries map[string]*cachedEntityType
+}
+
+type cachedEntityType struct {
+	// ready is closed once entityType and err are set.
+	ready      chan struct{}
+	entityType *APIEntityType
+	err        error
+}
+
+// CachedEntityType returns an entity type like GetEntityType, but fetches each entity type at most
+// once for the lifetime of the client, i.e. once per Terraform run. Concurrent callers share a
+// single request. Failed lookups are not cached, and entity types created, updated or deleted
+// through the client are fetched again on the next call.
+func (c *Client) CachedEntityType(ctx context.Context, identifier string) (*APIEntityType, error) {
+	cache := &c.entityTypes
+
+	cache.mu.Lock()
+	if cache.entries == nil {
+		cache.entries = map[string]*cachedEntityType{}
+	}
+	entry, ok := cache.entries[identifier]
+	if !ok {
+		entry = &cachedEntityType{ready: make(chan struct{})}
+		cache.entries[identifier] = entry
+	}
+	cache.mu.Unlock()
+
+	if ok {
+		select {
+		case <-entry.ready:
+			return entry.entityType, entry.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	apiResp, err := c.GetEntityType(ctx, identifier)
+	if err != nil {
+		entry.err = err
+		c.forgetEntityType(identifier, entry)
+	} else {
+		entry.entityType = &apiResp.EntityType
+	}
+	close(entry.ready)
+
+	return entry.entityType, entry.err
+}
+
+// forgetEntityType drops a cached entity type. If entry is not nil, only that entry is dropped, so
+// a failed lookup cannot evict a newer one.
+func (c *Client) forgetEntityType(identifier string, entry *cachedEntityType) {
+	cache := &c.entityTypes
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if current, ok := cache.entries[identifier]; ok && (entry == nil || current == entry) {
+		delete(cache.entries, identifier)
+	}
+}
@@ -0,0 +1,63 @@
+package dxapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestCachedEntityTypeFetchesOnce(t *testing.T) {
+	client, calls := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "entityTypes.update") {
+			_, _ = w.Write([]byte(`{"ok": true, "entity_type": {"identifier": "service"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok": true, "entity_type": {"identifier": "service", "properties": [{"identifier": "tier", "type": "text"}]}}`))
+	})
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			entityType, err := client.CachedEntityType(ctx, "service")
+			if err != nil || len(entityType.Properties) != 1 {
+				t.Errorf("Expected the service entity type, got %+v, %v", entityType, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Errorf("Expected concurrent lookups to share 1 request, got %d", calls.Load())
+	}
+
+	if _, err := client.UpdateEntityType(ctx, map[string]interface{}{"identifier": "service"}); err != nil {
+		t.Fatalf("UpdateEntityType failed: %s", err)
+	}
+	if _, err := client.CachedEntityType(ctx, "service"); err != nil {
+		t.Fatalf("CachedEntityType failed: %s", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Expected the entity type to be fetched again after an update, got %d requests in total", calls.Load())
+	}
+}
+
+func TestCachedEntityTypeDoesNotCacheErrors(t *testing.T) {
+	client, calls := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"ok": false, "error": "entity_type_not_found"}`))
+	})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.CachedEntityType(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("Expected a not found error, got: %v", err)
+		}
+	}
+	if calls.Load() != 2 {
+		t.Errorf("Expected failed lookups to be retried, got %d requests", calls.Load())
+	}
+}
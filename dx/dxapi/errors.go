@@ -0,0 +1,209 @@
+package dxapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxErrorBodyLength caps how much of a non-JSON error body is kept on an APIError.
+const maxErrorBodyLength = 2000
+
+// APIError is returned for every DX API response that has a non-success status code.
+//
+// The DX API reports failures with a body like:
+//
+// ```json
+// { "ok": false, "error": "not_found", "details": ... }
+// ```.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Code is the machine readable error code from the `error` field, e.g. `not_found`.
+	Code string
+	// Message is a human readable description of the failure, if the API provided one.
+	Message string
+	// RequestID identifies the request in DX's logs, if the API provided one.
+	RequestID string
+	// FieldErrors are validation failures attributed to individual request fields.
+	FieldErrors []FieldError
+}
+
+// FieldError is a validation failure for a single request field, e.g. `name` or `checks.0.sql`.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "DX API returned status %d", e.StatusCode)
+	if e.Code != "" {
+		fmt.Fprintf(&sb, " (%s)", e.Code)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&sb, ": %s", e.Message)
+	}
+	for _, fieldErr := range e.FieldErrors {
+		fmt.Fprintf(&sb, "\n  - %s: %s", fieldErr.Field, fieldErr.Message)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&sb, "\n(request ID: %s)", e.RequestID)
+	}
+	return sb.String()
+}
+
+// IsNotFound reports whether err is an APIError for an object that does not exist: any 404,
+// whatever its error code, or a `not_found` or `*_not_found` code with another status.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusNotFound || apiErr.Code == "not_found" || strings.HasSuffix(apiErr.Code, "_not_found")
+}
+
+// IsConflict reports whether err is an APIError caused by a conflicting object, e.g. a duplicate identifier.
+func IsConflict(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusConflict || apiErr.Code == "conflict" || strings.HasSuffix(apiErr.Code, "_already_exists")
+}
+
+// IsUnauthorized reports whether err is an APIError caused by a missing, invalid or insufficiently scoped API token.
+func IsUnauthorized(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden
+}
+
+// IsValidation reports whether err is an APIError caused by an invalid request payload.
+func IsValidation(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusBadRequest || apiErr.StatusCode == http.StatusUnprocessableEntity || len(apiErr.FieldErrors) > 0
+}
+
+func parseAPIError(statusCode int, requestID string, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		RequestID:  requestID,
+	}
+
+	var envelope struct {
+		Error     string          `json:"error"`
+		Message   string          `json:"message"`
+		Details   json.RawMessage `json:"details"`
+		RequestID string          `json:"request_id"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		apiErr.Message = truncate(strings.TrimSpace(string(body)), maxErrorBodyLength)
+		return apiErr
+	}
+
+	apiErr.Code = envelope.Error
+	apiErr.Message = envelope.Message
+	if apiErr.RequestID == "" {
+		apiErr.RequestID = envelope.RequestID
+	}
+	parseErrorDetails(apiErr, envelope.Details)
+
+	return apiErr
+}
+
+// parseErrorDetails extracts a message and field-level errors from the `details` value, which
+// the API returns as a string, a list of messages, or an object keyed by field name.
+func parseErrorDetails(apiErr *APIError, details json.RawMessage) {
+	if len(details) == 0 || string(details) == "null" {
+		return
+	}
+
+	var message string
+	if err := json.Unmarshal(details, &message); err == nil {
+		apiErr.Message = joinMessages(apiErr.Message, message)
+		return
+	}
+
+	var list []json.RawMessage
+	if err := json.Unmarshal(details, &list); err == nil {
+		for _, item := range list {
+			var fieldErr struct {
+				Field   string `json:"field"`
+				Message string `json:"message"`
+			}
+			if err := json.Unmarshal(item, &message); err == nil {
+				apiErr.Message = joinMessages(apiErr.Message, message)
+			} else if err := json.Unmarshal(item, &fieldErr); err == nil && fieldErr.Field != "" {
+				apiErr.FieldErrors = append(apiErr.FieldErrors, FieldError{Field: fieldErr.Field, Message: fieldErr.Message})
+			}
+		}
+		return
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(details, &fields); err != nil {
+		return
+	}
+
+	// Sort field names so error messages are stable
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		raw := fields[name]
+		if name == "message" {
+			if err := json.Unmarshal(raw, &message); err == nil {
+				apiErr.Message = joinMessages(apiErr.Message, message)
+			}
+			continue
+		}
+		if name == "errors" {
+			parseErrorDetails(apiErr, raw)
+			continue
+		}
+
+		var messages []string
+		if err := json.Unmarshal(raw, &message); err == nil {
+			messages = []string{message}
+		} else if err := json.Unmarshal(raw, &messages); err != nil {
+			messages = []string{string(raw)}
+		}
+		for _, msg := range messages {
+			apiErr.FieldErrors = append(apiErr.FieldErrors, FieldError{Field: name, Message: msg})
+		}
+	}
+}
+
+func joinMessages(existing, message string) string {
+	if existing == "" {
+		return message
+	}
+	if message == "" {
+		return existing
+	}
+	return existing + "; " + message
+}
+
+// truncate shortens s to at most maxLength bytes, without splitting a UTF-8 encoded character.
+func truncate(s string, maxLength int) string {
+	if len(s) <= maxLength {
+		return s
+	}
+	for maxLength > 0 && !utf8.RuneStart(s[maxLength]) {
+		maxLength--
+	}
+	return s[:maxLength] + "..."
+}
@@ -0,0 +1,123 @@
+package dxapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseAPIError(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		expected *APIError
+	}{
+		{
+			name:     "error code only",
+			status:   http.StatusNotFound,
+			body:     `{"ok": false, "error": "entity_not_found"}`,
+			expected: &APIError{StatusCode: 404, Code: "entity_not_found"},
+		},
+		{
+			name:     "string details",
+			status:   http.StatusBadRequest,
+			body:     `{"ok": false, "error": "invalid_request", "details": "identifier is malformed"}`,
+			expected: &APIError{StatusCode: 400, Code: "invalid_request", Message: "identifier is malformed"},
+		},
+		{
+			name:   "field details",
+			status: http.StatusUnprocessableEntity,
+			body:   `{"ok": false, "error": "validation_failed", "details": {"name": "can't be blank", "checks.0.sql": ["is invalid", "is too long"]}}`,
+			expected: &APIError{
+				StatusCode: 422,
+				Code:       "validation_failed",
+				FieldErrors: []FieldError{
+					{Field: "checks.0.sql", Message: "is invalid"},
+					{Field: "checks.0.sql", Message: "is too long"},
+					{Field: "name", Message: "can't be blank"},
+				},
+			},
+		},
+		{
+			name:   "list details",
+			status: http.StatusBadRequest,
+			body:   `{"ok": false, "error": "invalid_request", "message": "Bad request", "details": ["owner not found", {"field": "type", "message": "is unknown"}], "request_id": "req-1"}`,
+			expected: &APIError{
+				StatusCode:  400,
+				Code:        "invalid_request",
+				Message:     "Bad request; owner not found",
+				RequestID:   "req-1",
+				FieldErrors: []FieldError{{Field: "type", Message: "is unknown"}},
+			},
+		},
+		{
+			name:     "non-JSON body",
+			status:   http.StatusBadGateway,
+			body:     "<html>Bad Gateway</html>\n",
+			expected: &APIError{StatusCode: 502, Message: "<html>Bad Gateway</html>"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseAPIError(tt.status, "", []byte(tt.body))
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestAPIErrorPredicates(t *testing.T) {
+	notFound := &APIError{StatusCode: 404}
+	notFoundCode := &APIError{StatusCode: 400, Code: "scorecard_not_found"}
+	conflict := &APIError{StatusCode: 409}
+	unauthorized := &APIError{StatusCode: 401}
+	wrapped := fmt.Errorf("reading entity: %w", notFound)
+
+	if !IsNotFound(notFound) || !IsNotFound(notFoundCode) || !IsNotFound(wrapped) {
+		t.Error("Expected not found errors to be detected")
+	}
+	if IsNotFound(conflict) || IsNotFound(errors.New("not_found")) {
+		t.Error("Expected only not found API errors to be detected")
+	}
+	if !IsNotFound(&APIError{StatusCode: 404, Code: "entity_missing"}) {
+		t.Error("Expected a 404 with any error code to be treated as a missing object")
+	}
+	if !IsConflict(conflict) || IsConflict(notFound) {
+		t.Error("Expected IsConflict to only match conflicts")
+	}
+	if !IsUnauthorized(unauthorized) || IsUnauthorized(notFound) {
+		t.Error("Expected IsUnauthorized to only match authentication failures")
+	}
+}
+
+func TestClientReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"ok": false, "error": "entity_not_found"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, "token", "test")
+
+	_, err := client.GetEntity(context.Background(), "missing")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected an *APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.RequestID != "req-123" {
+		t.Errorf("Unexpected API error: %+v", apiErr)
+	}
+	if !strings.Contains(err.Error(), "req-123") {
+		t.Errorf("Expected error message to include the request ID, got %q", err.Error())
+	}
+}
@@ -0,0 +1,108 @@
+package dxapi
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxLoggedBodyLength caps how much of a request or response body is written to the logs.
+const maxLoggedBodyLength = 4096
+
+// redactedValue replaces the values of sensitive keys in logged bodies.
+const redactedValue = "***"
+
+// DefaultRedactedKeys are the body keys whose values are never logged. A key is redacted when
+// its name contains one of these, ignoring case, so `email` also covers `owner_user_emails`.
+var DefaultRedactedKeys = []string{"token", "secret", "password", "authorization", "email"}
+
+// WithRedactedKeys masks the values of the given body keys in logs, in addition to
+// DefaultRedactedKeys. Use it for keys whose values are sensitive for a particular
+// organisation, e.g. `properties`.
+func WithRedactedKeys(keys ...string) Option {
+	return func(c *Client) {
+		for _, key := range keys {
+			c.redactedKeys = append(c.redactedKeys, strings.ToLower(key))
+		}
+	}
+}
+
+// apiCall describes a completed request to the DX API, for logging.
+type apiCall struct {
+	method       string
+	path         string
+	status       int
+	duration     time.Duration
+	requestID    string
+	requestBody  []byte
+	responseBody []byte
+}
+
+// logAPICall is the single place requests to the DX API are logged. Metadata is logged at
+// debug level, and the redacted, truncated bodies only at trace level.
+func (c *Client) logAPICall(ctx context.Context, call apiCall) {
+	fields := map[string]interface{}{
+		"method":      call.method,
+		"path":        call.path,
+		"status":      call.status,
+		"duration_ms": call.duration.Milliseconds(),
+	}
+	if call.requestID != "" {
+		fields["request_id"] = call.requestID
+	}
+	tflog.Debug(ctx, "DX API request completed", fields)
+
+	if len(call.requestBody) > 0 {
+		fields["request_body"] = c.redactBody(call.requestBody)
+	}
+	if len(call.responseBody) > 0 {
+		fields["response_body"] = c.redactBody(call.responseBody)
+	}
+	tflog.Trace(ctx, "DX API request and response bodies", fields)
+}
+
+// redactBody masks the values of sensitive keys in a JSON body and truncates it. Bodies that
+// are not JSON are only truncated.
+func (c *Client) redactBody(body []byte) string {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return truncate(string(body), maxLoggedBodyLength)
+	}
+
+	redacted, err := json.Marshal(c.redactValue(value))
+	if err != nil {
+		return truncate(string(body), maxLoggedBodyLength)
+	}
+	return truncate(string(redacted), maxLoggedBodyLength)
+}
+
+func (c *Client) redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if c.isRedactedKey(key) {
+				v[key] = redactedValue
+			} else {
+				v[key] = c.redactValue(nested)
+			}
+		}
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = c.redactValue(nested)
+		}
+	}
+	return value
+}
+
+func (c *Client) isRedactedKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, redacted := range c.redactedKeys {
+		if strings.Contains(key, redacted) {
+			return true
+		}
+	}
+	return false
+}
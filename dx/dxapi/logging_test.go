@@ -0,0 +1,72 @@
+package dxapi
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactBody(t *testing.T) {
+	client := NewClient("http://localhost", "token", "test", WithRedactedKeys("Properties"))
+
+	body := `{"identifier":"svc","owner_user_emails":["a@example.com"],"properties":{"url":"https://internal"},"aliases":[{"api_token":"abc"}]}`
+	expected := `{"aliases":[{"api_token":"***"}],"identifier":"svc","owner_user_emails":"***","properties":"***"}`
+
+	if got := client.redactBody([]byte(body)); got != expected {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
+
+func TestRedactBodyTruncatesLargeBodies(t *testing.T) {
+	client := NewClient("http://localhost", "token", "test")
+
+	got := client.redactBody([]byte(strings.Repeat("x", maxLoggedBodyLength*2)))
+	if len(got) != maxLoggedBodyLength+len("...") {
+		t.Errorf("Expected the body to be truncated to %d characters, got %d", maxLoggedBodyLength, len(got))
+	}
+
+	// Truncation never splits a multi-byte character
+	got = client.redactBody([]byte("x" + strings.Repeat("é", maxLoggedBodyLength)))
+	if !utf8.ValidString(got) || len(got) != maxLoggedBodyLength-1+len("...") {
+		t.Errorf("Expected the body to be truncated on a character boundary, got %d bytes", len(got))
+	}
+}
+
+func TestRequestsAreLoggedWithoutSecrets(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		_, _ = w.Write([]byte(`{"ok": true, "entity": {"identifier": "svc", "type": "service", "owner_users": [{"id": "1", "email": "a@example.com"}]}}`))
+	})
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	if _, err := client.GetEntity(ctx, "svc"); err != nil {
+		t.Fatalf("GetEntity failed: %s", err)
+	}
+
+	logged := output.String()
+	entries, err := tflogtest.MultilineJSONDecode(strings.NewReader(logged))
+	if err != nil {
+		t.Fatalf("Decoding log output failed: %s", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Expected a debug and a trace log entry, got %d: %v", len(entries), entries)
+	}
+
+	debug, trace := entries[0], entries[1]
+	if debug["@level"] != "debug" || debug["path"] != "entities.info" || debug["request_id"] != "req-1" || debug["status"] != float64(200) {
+		t.Errorf("Unexpected debug log entry: %v", debug)
+	}
+	if _, ok := debug["response_body"]; ok {
+		t.Error("Expected the response body to only be logged at trace level")
+	}
+	if trace["@level"] != "trace" || strings.Contains(logged, "a@example.com") {
+		t.Errorf("Expected the trace log entry to redact emails, got: %v", trace)
+	}
+}
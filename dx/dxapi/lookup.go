@@ -0,0 +1,91 @@
+package dxapi
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// LookupError is returned when objects looked up by a human readable key, like a team name or a
+// user email, cannot be resolved to exactly one object each.
+type LookupError struct {
+	// Kind is the kind of object looked up, e.g. `team`.
+	Kind string
+	// Missing are the keys that matched no object.
+	Missing []string
+	// Ambiguous are the keys that matched more than one object.
+	Ambiguous []string
+}
+
+func (e *LookupError) Error() string {
+	var problems []string
+	if len(e.Missing) > 0 {
+		problems = append(problems, fmt.Sprintf("no %s matches %s", e.Kind, quoteAll(e.Missing)))
+	}
+	if len(e.Ambiguous) > 0 {
+		problems = append(problems, fmt.Sprintf("more than one %s matches %s", e.Kind, quoteAll(e.Ambiguous)))
+	}
+	return strings.Join(problems, "; ")
+}
+
+// lookup resolves each key to the single item it matches.
+func lookup[T any](kind string, keys []string, items []T, matches func(T, string) bool) ([]T, error) {
+	found := make([]T, 0, len(keys))
+	lookupErr := &LookupError{Kind: kind}
+
+	for _, key := range keys {
+		var matched []T
+		for _, item := range items {
+			if matches(item, key) {
+				matched = append(matched, item)
+			}
+		}
+
+		switch len(matched) {
+		case 0:
+			lookupErr.Missing = append(lookupErr.Missing, key)
+		case 1:
+			found = append(found, matched[0])
+		default:
+			lookupErr.Ambiguous = append(lookupErr.Ambiguous, key)
+		}
+	}
+
+	if len(lookupErr.Missing) > 0 || len(lookupErr.Ambiguous) > 0 {
+		return nil, lookupErr
+	}
+	return found, nil
+}
+
+// directory remembers the full listing of a kind of object that the provider never changes, like
+// teams and users, so resolving them for many resources costs a single listing.
+type directory[T any] struct {
+	mu     sync.Mutex
+	items  []T
+	loaded bool
+}
+
+// load returns the cached listing, calling list the first time. Concurrent callers wait for a
+// single listing. Failed listings are not cached.
+func (d *directory[T]) load(list func() ([]T, error)) ([]T, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.loaded {
+		return d.items, nil
+	}
+	items, err := list()
+	if err != nil {
+		return nil, err
+	}
+	d.items, d.loaded = items, true
+	return d.items, nil
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+	return strings.Join(quoted, ", ")
+}
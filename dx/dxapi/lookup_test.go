@@ -0,0 +1,45 @@
+package dxapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestFindTeamsByName(t *testing.T) {
+	client, calls := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok": true, "teams": [{"id": "t1", "name": "Platform"}, {"id": "t2", "name": "Payments"}, {"id": "t3", "name": "Payments"}, {"id": "t4", "name": "Data"}]}`))
+	})
+	ctx := context.Background()
+
+	teams, err := client.FindTeamsByName(ctx, []string{"Data", "Platform"})
+	if err != nil {
+		t.Fatalf("FindTeamsByName failed: %s", err)
+	}
+	if ids := []string{teams[0].Id, teams[1].Id}; !reflect.DeepEqual(ids, []string{"t4", "t1"}) {
+		t.Errorf("Expected the teams in the requested order, got %v", ids)
+	}
+
+	_, err = client.FindTeamsByName(ctx, []string{"platform", "Payments"})
+	var lookupErr *LookupError
+	if !errors.As(err, &lookupErr) || !reflect.DeepEqual(lookupErr.Missing, []string{"platform"}) || !reflect.DeepEqual(lookupErr.Ambiguous, []string{"Payments"}) {
+		t.Errorf("Expected a lookup error for the lowercase and duplicate names, got: %v", err)
+	}
+
+	if calls.Load() != 1 {
+		t.Errorf("Expected the teams to be listed once, got %d requests", calls.Load())
+	}
+}
+
+func TestFindUsersByEmailIgnoresCase(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok": true, "users": [{"id": "u1", "email": "ada@example.com"}]}`))
+	})
+
+	users, err := client.FindUsersByEmail(context.Background(), []string{"Ada@Example.com"})
+	if err != nil || len(users) != 1 || users[0].Id != "u1" {
+		t.Errorf("Expected user u1, got %+v, %v", users, err)
+	}
+}
@@ -0,0 +1,98 @@
+package dxapi
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// DefaultPageSize is the number of items requested per page from list endpoints.
+	DefaultPageSize = 50
+	// MaxPageSize is the largest page the DX API returns.
+	MaxPageSize = 100
+)
+
+// PageOptions controls how a paginated list endpoint is read.
+type PageOptions struct {
+	// PageSize is the number of items requested per page. Zero uses DefaultPageSize.
+	PageSize int
+	// MaxResults stops the iteration after this many items. Zero means no limit.
+	MaxResults int
+}
+
+// ResponseMetadata is the pagination metadata of list responses.
+type ResponseMetadata struct {
+	NextCursor string `json:"next_cursor"`
+}
+
+// paginate lazily reads a cursor paginated list endpoint, one page at a time. Items are yielded
+// as they arrive, so callers that stop early never request the remaining pages. An error ends
+// the iteration after being yielded once.
+//
+// items extracts the page's items and the cursor of the next page from a decoded response R.
+func paginate[R any, T any](ctx context.Context, c *Client, path string, query url.Values, opts PageOptions, items func(*R) ([]T, string)) iter.Seq2[T, error] {
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	pageSize = min(pageSize, MaxPageSize)
+
+	return func(yield func(T, error) bool) {
+		query := cloneValues(query)
+		yielded := 0
+
+		for {
+			limit := pageSize
+			if opts.MaxResults > 0 {
+				limit = min(limit, opts.MaxResults-yielded)
+			}
+			query.Set("limit", strconv.Itoa(limit))
+
+			resp, err := do[R](ctx, c, http.MethodGet, path, query, nil)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			page, nextCursor := items(resp)
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+				yielded++
+				if opts.MaxResults > 0 && yielded >= opts.MaxResults {
+					return
+				}
+			}
+
+			if nextCursor == "" || len(page) == 0 {
+				return
+			}
+			query.Set("cursor", nextCursor)
+		}
+	}
+}
+
+// collect drains seq into a slice, stopping at the first error.
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var all []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, item)
+	}
+	return all, nil
+}
+
+func cloneValues(values url.Values) url.Values {
+	cloned := make(url.Values, len(values))
+	for key, value := range values {
+		cloned[key] = append([]string(nil), value...)
+	}
+	return cloned
+}
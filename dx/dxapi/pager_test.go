@@ -0,0 +1,94 @@
+package dxapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// entityPages serves numPages pages of pageSize entities each, honouring the requested limit.
+func entityPages(t *testing.T, numPages int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page := 0
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			page, _ = strconv.Atoi(cursor)
+		}
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil {
+			t.Errorf("Expected a numeric limit, got %q", r.URL.Query().Get("limit"))
+		}
+
+		entities := ""
+		for i := 0; i < limit; i++ {
+			if i > 0 {
+				entities += ","
+			}
+			entities += fmt.Sprintf(`{"identifier": "svc-%d-%d", "type": "service"}`, page, i)
+		}
+
+		nextCursor := ""
+		if page+1 < numPages {
+			nextCursor = strconv.Itoa(page + 1)
+		}
+		_, _ = fmt.Fprintf(w, `{"ok": true, "entities": [%s], "response_metadata": {"next_cursor": %q}}`, entities, nextCursor)
+	}
+}
+
+func TestListEntitiesReadsAllPages(t *testing.T) {
+	client, calls := newTestClient(t, entityPages(t, 3))
+
+	entities, err := client.ListEntities(context.Background(), "service", &ListEntitiesOptions{PageOptions: PageOptions{PageSize: 10}})
+	if err != nil {
+		t.Fatalf("ListEntities failed: %s", err)
+	}
+	if len(entities) != 30 || calls.Load() != 3 {
+		t.Errorf("Expected 30 entities from 3 requests, got %d entities from %d requests", len(entities), calls.Load())
+	}
+	if entities[29].Identifier != "svc-2-9" {
+		t.Errorf("Expected entities in page order, last entity was %s", entities[29].Identifier)
+	}
+}
+
+func TestIterateEntitiesStopsAtMaxResults(t *testing.T) {
+	client, calls := newTestClient(t, entityPages(t, 10))
+
+	entities, err := client.ListEntities(context.Background(), "service", &ListEntitiesOptions{PageOptions: PageOptions{PageSize: 10, MaxResults: 15}})
+	if err != nil {
+		t.Fatalf("ListEntities failed: %s", err)
+	}
+	if len(entities) != 15 || calls.Load() != 2 {
+		t.Errorf("Expected 15 entities from 2 requests, got %d entities from %d requests", len(entities), calls.Load())
+	}
+}
+
+func TestIterateEntitiesStopsWhenCallerBreaks(t *testing.T) {
+	client, calls := newTestClient(t, entityPages(t, 10))
+
+	count := 0
+	for _, err := range client.IterateEntities(context.Background(), "service", &ListEntitiesOptions{PageOptions: PageOptions{PageSize: 5}}) {
+		if err != nil {
+			t.Fatalf("IterateEntities failed: %s", err)
+		}
+		count++
+		if count == 7 {
+			break
+		}
+	}
+
+	if calls.Load() != 2 {
+		t.Errorf("Expected only the pages that were consumed to be requested, got %d requests", calls.Load())
+	}
+}
+
+func TestIterateEntitiesYieldsErrors(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"ok": false, "error": "invalid_scope"}`))
+	})
+
+	if _, err := client.ListEntities(context.Background(), "service", nil); !IsUnauthorized(err) {
+		t.Errorf("Expected the API error to be returned, got: %v", err)
+	}
+}
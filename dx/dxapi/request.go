@@ -0,0 +1,104 @@
+package dxapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// okResponse is the envelope shared by every DX API response, used for endpoints whose
+// response carries nothing else of interest, e.g. deletes.
+type okResponse struct {
+	Ok bool `json:"ok"`
+}
+
+// do sends a request to the DX API endpoint at path (e.g. `entities.info`) and decodes the JSON
+// response into a T. body, if not nil, is sent as the JSON request body.
+//
+// Non-2xx responses, and 2xx responses whose body reports `"ok": false`, are returned as an
+// *APIError. A response without a body decodes to the zero value of T.
+func do[T any](ctx context.Context, c *Client, method, path string, query url.Values, body any) (*T, error) {
+	reqURL := fmt.Sprintf("%s/%s", c.baseURL, path)
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	var payload []byte
+	var reqBody io.Reader
+	if body != nil {
+		var err error
+		payload, err = json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling payload: %w", err)
+		}
+		reqBody = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	setRequestHeaders(req, c)
+
+	start := time.Now()
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, fmt.Errorf("making HTTP request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading API response: %w", err)
+	}
+
+	requestID := resp.Header.Get("X-Request-Id")
+	c.logAPICall(ctx, apiCall{
+		method:       method,
+		path:         path,
+		status:       resp.StatusCode,
+		duration:     time.Since(start),
+		requestID:    requestID,
+		requestBody:  payload,
+		responseBody: respBody,
+	})
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, parseAPIError(resp.StatusCode, requestID, respBody)
+	}
+
+	var result T
+	if len(bytes.TrimSpace(respBody)) == 0 {
+		return &result, nil
+	}
+
+	// Some failures are reported with a success status code and `"ok": false` in the body
+	var envelope struct {
+		Ok *bool `json:"ok"`
+	}
+	if err := json.Unmarshal(respBody, &envelope); err == nil && envelope.Ok != nil && !*envelope.Ok {
+		return nil, parseAPIError(resp.StatusCode, requestID, respBody)
+	}
+
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("decoding API response: %w", err)
+	}
+
+	return &result, nil
+}
+
+func setRequestHeaders(req *http.Request, client *Client) {
+	for name, value := range client.headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+client.token)
+	req.Header.Set("X-Client-Type", "terraform-provider-dx")
+	req.Header.Set("X-Client-Version", client.version)
+}
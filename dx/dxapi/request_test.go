@@ -0,0 +1,56 @@
+package dxapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestDoSendsQueryBodyAndHeaders(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/entities.update" || r.URL.Query().Get("identifier") != "a b" {
+			t.Errorf("Unexpected request URL: %s", r.URL)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("Unexpected Authorization header: %q", got)
+		}
+
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil || payload["name"] != "Payments" {
+			t.Errorf("Unexpected request body: %v (%v)", payload, err)
+		}
+		_, _ = w.Write([]byte(`{"ok": true, "entity": {"identifier": "a b", "type": "service"}}`))
+	})
+
+	resp, err := do[APIEntityResponse](context.Background(), client, http.MethodPost, "entities.update", url.Values{"identifier": {"a b"}}, map[string]string{"name": "Payments"})
+	if err != nil {
+		t.Fatalf("Expected request to succeed, got: %s", err)
+	}
+	if resp.Entity.Identifier != "a b" || resp.Entity.Type != "service" {
+		t.Errorf("Unexpected decoded response: %+v", resp.Entity)
+	}
+}
+
+func TestDoReturnsAPIErrorWhenOkIsFalse(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok": false, "error": "entity_not_found"}`))
+	})
+
+	_, err := do[APIEntityResponse](context.Background(), client, http.MethodGet, "entities.info", nil, nil)
+	if !IsNotFound(err) {
+		t.Errorf("Expected a not found API error, got: %v", err)
+	}
+}
+
+func TestDoAcceptsEmptyResponse(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := do[okResponse](context.Background(), client, http.MethodPost, "entities.delete", nil, nil)
+	if err != nil || resp == nil {
+		t.Errorf("Expected an empty response to succeed, got %v, %v", resp, err)
+	}
+}
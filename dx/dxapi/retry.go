@@ -0,0 +1,152 @@
+package dxapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// send executes the request, retrying transient failures with jittered exponential backoff.
+// Every attempt goes through the client's rate limiter and concurrency cap.
+//
+// Rate limited responses (429) are retried for every request. Server errors and connection
+// failures are only retried for idempotent requests, because a create request that reached
+// the server may already have been applied.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	idempotent := isIdempotent(req)
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		release, err := c.acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.httpClient.Do(attemptReq)
+		if err != nil {
+			release()
+		} else {
+			resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+		}
+
+		if attempt >= c.retry.MaxRetries || !shouldRetry(ctx, resp, err, idempotent) {
+			return resp, err
+		}
+
+		wait := c.retry.backoff(attempt, resp)
+
+		fields := map[string]interface{}{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			// Drain the body so the underlying connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		tflog.Warn(ctx, "Retrying DX API request after transient failure", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// isIdempotent reports whether a request can be safely repeated. The DX Web API names its
+// endpoints `<resource>.<action>`, and only `create` actions produce a new object per call.
+func isIdempotent(req *http.Request) bool {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return true
+	}
+	return !strings.HasSuffix(req.URL.Path, ".create")
+}
+
+func shouldRetry(ctx context.Context, resp *http.Response, err error, idempotent bool) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		// A refused connection never reached the server, so it is safe to retry any request.
+		return idempotent || errors.Is(err, syscall.ECONNREFUSED)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode == http.StatusServiceUnavailable:
+		return true
+	case resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented:
+		return idempotent
+	default:
+		return false
+	}
+}
+
+// backoff returns how long to wait before the next attempt. A `Retry-After` header on the
+// response takes precedence over the computed exponential delay.
+func (cfg RetryConfig) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, cfg.MaxWait)
+		}
+	}
+
+	wait := cfg.MinWait << attempt
+	if wait <= 0 || wait > cfg.MaxWait {
+		wait = cfg.MaxWait
+	}
+
+	// Full jitter over the upper half of the window, so concurrent clients spread out
+	// without collapsing the delay to zero.
+	half := wait / 2
+	if half <= 0 {
+		return wait
+	}
+	return half + rand.N(half+1)
+}
+
+// parseRetryAfter parses a `Retry-After` header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,137 @@
+package dxapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, "token", "test", WithRetry(RetryConfig{
+		MaxRetries: 3,
+		MinWait:    time.Millisecond,
+		MaxWait:    10 * time.Millisecond,
+	}))
+	return client, &calls
+}
+
+func TestSendRetriesServerErrorsForReads(t *testing.T) {
+	var attempts atomic.Int32
+	client, calls := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok": true, "entity": {"identifier": "svc", "type": "service"}}`))
+	})
+
+	resp, err := client.GetEntity(context.Background(), "svc")
+	if err != nil {
+		t.Fatalf("Expected GetEntity to succeed after retries, got: %s", err)
+	}
+	if resp.Entity.Identifier != "svc" {
+		t.Errorf("Expected identifier `svc`, got `%s`", resp.Entity.Identifier)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("Expected 3 attempts, got %d", got)
+	}
+}
+
+func TestSendDoesNotRetryServerErrorsForCreates(t *testing.T) {
+	client, calls := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.CreateEntity(context.Background(), map[string]interface{}{"identifier": "svc"})
+	if err == nil {
+		t.Fatal("Expected CreateEntity to fail")
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("Expected 1 attempt, got %d", got)
+	}
+}
+
+func TestSendRetriesRateLimitedCreatesWithBody(t *testing.T) {
+	var attempts atomic.Int32
+	client, calls := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength == 0 {
+			t.Errorf("Expected request body to be resent on attempt %d", attempts.Load()+1)
+		}
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok": true, "entity": {"identifier": "svc", "type": "service"}}`))
+	})
+
+	if _, err := client.CreateEntity(context.Background(), map[string]interface{}{"identifier": "svc"}); err != nil {
+		t.Fatalf("Expected CreateEntity to succeed after a rate limited attempt, got: %s", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("Expected 2 attempts, got %d", got)
+	}
+}
+
+func TestSendGivesUpAfterMaxRetries(t *testing.T) {
+	client, calls := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	if _, err := client.GetScorecard(context.Background(), "abc"); err == nil {
+		t.Fatal("Expected GetScorecard to fail")
+	}
+	if got := calls.Load(); got != 4 {
+		t.Errorf("Expected 4 attempts (1 + 3 retries), got %d", got)
+	}
+}
+
+func TestSendStopsWhenContextIsCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client, calls := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	client.retry.MaxWait = time.Minute
+
+	if _, err := client.GetEntityType(ctx, "service"); err == nil {
+		t.Fatal("Expected GetEntityType to fail")
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("Expected 1 attempt, got %d", got)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cfg := RetryConfig{MinWait: 100 * time.Millisecond, MaxWait: time.Second}
+
+	for attempt := 0; attempt < 6; attempt++ {
+		wait := cfg.backoff(attempt, nil)
+		if wait <= 0 || wait > cfg.MaxWait {
+			t.Errorf("Attempt %d: wait %s is outside (0, %s]", attempt, wait, cfg.MaxWait)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"5"}}}
+	if wait := cfg.backoff(0, resp); wait != cfg.MaxWait {
+		t.Errorf("Expected Retry-After to be capped at %s, got %s", cfg.MaxWait, wait)
+	}
+
+	resp.Header.Set("Retry-After", "0")
+	if wait := cfg.backoff(3, resp); wait != 0 {
+		t.Errorf("Expected Retry-After of 0 to be honoured, got %s", wait)
+	}
+}
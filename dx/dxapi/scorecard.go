@@ -1,12 +1,12 @@
 package dxapi
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
+	"net/url"
 	"os"
 	"time"
 
@@ -36,9 +36,14 @@ type APIScorecard struct {
 	Published                   bool        `json:"published"`
 	EntityFilterTypeIdentifiers []*string   `json:"entity_filter_type_identifiers"`
 	EntityFilterSql             *string     `json:"entity_filter_sql"`
+	Tags                        []APITag    `json:"tags"`
 	Checks                      []*APICheck `json:"checks"`
 }
 
+type APITag struct {
+	Value string `json:"value"`
+}
+
 type APILevel struct {
 	Key   *string `json:"key"`
 	Id    *string `json:"id"`
@@ -122,188 +127,197 @@ type APIResponse struct {
 	Scorecard APIScorecard `json:"scorecard"`
 }
 
-func (c *Client) CreateScorecard(ctx context.Context, payload map[string]interface{}) (*APIResponse, error) {
-	tflog.Info(ctx, "Calling CreateScorecard")
-
-	body, err := json.MarshalIndent(payload, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshaling payload: %w", err)
-	}
+// APIScorecardsListResponse is the top-level response from the DX API for the scorecards.list endpoint.
+type APIScorecardsListResponse struct {
+	Ok               bool             `json:"ok"`
+	Scorecards       []APIScorecard   `json:"scorecards"`
+	ResponseMetadata ResponseMetadata `json:"response_metadata"`
+}
 
-	url := fmt.Sprintf("%s/scorecards.create", c.baseURL)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
+// IterateScorecards lazily lists every scorecard, requesting further pages only as the caller
+// consumes them.
+func (c *Client) IterateScorecards(ctx context.Context, opts *PageOptions) iter.Seq2[APIScorecard, error] {
+	var pageOpts PageOptions
+	if opts != nil {
+		pageOpts = *opts
 	}
 
-	setRequestHeaders(req, c)
-
-	tflog.Info(ctx, fmt.Sprintf("Request body:\n%s", string(body)))
+	return paginate(ctx, c, "scorecards.list", url.Values{}, pageOpts, func(resp *APIScorecardsListResponse) ([]APIScorecard, string) {
+		return resp.Scorecards, resp.ResponseMetadata.NextCursor
+	})
+}
 
-	// Make the request
-	resp, err := c.httpClient.Do(req)
+// ListScorecards returns every scorecard, see IterateScorecards.
+func (c *Client) ListScorecards(ctx context.Context) ([]APIScorecard, error) {
+	scorecards, err := collect(c.IterateScorecards(ctx, nil))
 	if err != nil {
-		return nil, fmt.Errorf("making HTTP request: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("unexpected status code: %d, response body: %s", resp.StatusCode, string(body))
-	}
-
-	// Decode the response into the APIResponse struct
-	var apiResp APIResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, fmt.Errorf("decoding API response: %w", err)
-	}
-
-	// Log the API response for debugging
-	if respJson, err := json.MarshalIndent(apiResp, "", "  "); err == nil {
-		tflog.Info(ctx, fmt.Sprintf("API Response from CreateScorecard:\n%s", string(respJson)))
-	} else {
-		tflog.Debug(ctx, "Could not marshal API response", map[string]interface{}{
-			"error": err,
-		})
-	}
+	tflog.Debug(ctx, "Listed scorecards", map[string]interface{}{
+		"count": len(scorecards),
+	})
+	return scorecards, nil
+}
 
-	// Return the APIResponse object
-	return &apiResp, nil
+func (c *Client) CreateScorecard(ctx context.Context, payload map[string]interface{}) (*APIResponse, error) {
+	return do[APIResponse](ctx, c, http.MethodPost, "scorecards.create", nil, payload)
 }
 
 func (c *Client) GetScorecard(ctx context.Context, id string) (*APIResponse, error) {
-	url := fmt.Sprintf("%s/scorecards.info?id=%s", c.baseURL, id)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
-	}
-
-	setRequestHeaders(req, c)
+	return do[APIResponse](ctx, c, http.MethodGet, "scorecards.info", url.Values{"id": {id}}, nil)
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("making HTTP request: %w", err)
-	}
-	defer resp.Body.Close()
+func (c *Client) UpdateScorecard(ctx context.Context, payload map[string]interface{}) (*APIResponse, error) {
+	return do[APIResponse](ctx, c, http.MethodPost, "scorecards.update", nil, payload)
+}
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("unexpected status code: %d, response body: %s", resp.StatusCode, string(body))
+func (c *Client) DeleteScorecard(ctx context.Context, id string) (bool, error) {
+	// The fake evaluates checks synchronously, so only the real API needs the delay
+	if os.Getenv("TF_ACC") == "1" && os.Getenv("DX_WEB_API_FAKE") == "" {
+		// This is a workaround for a possible race condition where a scorecard might be evaluating scorecard checks.
+		tflog.Info(ctx, "Acceptance test environment detected, sleeping for 2 seconds before proceeding with delete...")
+		time.Sleep(2 * time.Second)
 	}
 
-	var apiResp APIResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, fmt.Errorf("decoding API response: %w", err)
+	payload := map[string]interface{}{"id": id}
+	if _, err := do[okResponse](ctx, c, http.MethodPost, "scorecards.delete", nil, payload); err != nil {
+		// The object is already gone, which is what the caller asked for
+		if IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
 	}
+	return true, nil
+}
 
-	// Log the API response for debugging
-	if respJson, err := json.MarshalIndent(apiResp, "", "  "); err == nil {
-		tflog.Info(ctx, fmt.Sprintf("API Response from GetScorecard:\n%s", string(respJson)))
-	} else {
-		tflog.Debug(ctx, "Could not marshal API response", map[string]interface{}{
-			"error": err,
-		})
-	}
+// APIScorecardCheckResponse is the top-level response from the DX API for the scorecards.checks
+// endpoints, which manage a single check without rewriting the rest of its scorecard.
+type APIScorecardCheckResponse struct {
+	Ok    bool     `json:"ok"`
+	Check APICheck `json:"check"`
+}
 
-	return &apiResp, nil
+// CreateScorecardCheck adds a check to a scorecard. The payload names the scorecard with
+// `scorecard_id`, and the level or check group with `scorecard_level_id` or
+// `scorecard_check_group_id`.
+func (c *Client) CreateScorecardCheck(ctx context.Context, payload map[string]interface{}) (*APIScorecardCheckResponse, error) {
+	return do[APIScorecardCheckResponse](ctx, c, http.MethodPost, "scorecards.checks.create", nil, payload)
 }
 
-func (c *Client) UpdateScorecard(ctx context.Context, payload map[string]interface{}) (*APIResponse, error) {
-	tflog.Info(ctx, "Calling UpdateScorecard")
+func (c *Client) GetScorecardCheck(ctx context.Context, scorecardId, id string) (*APIScorecardCheckResponse, error) {
+	return do[APIScorecardCheckResponse](ctx, c, http.MethodGet, "scorecards.checks.info", url.Values{"scorecard_id": {scorecardId}, "id": {id}}, nil)
+}
 
-	body, err := json.MarshalIndent(payload, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshaling payload: %w", err)
-	}
+func (c *Client) UpdateScorecardCheck(ctx context.Context, payload map[string]interface{}) (*APIScorecardCheckResponse, error) {
+	return do[APIScorecardCheckResponse](ctx, c, http.MethodPost, "scorecards.checks.update", nil, payload)
+}
 
-	url := fmt.Sprintf("%s/scorecards.update", c.baseURL)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
+func (c *Client) DeleteScorecardCheck(ctx context.Context, scorecardId, id string) (bool, error) {
+	payload := map[string]interface{}{"scorecard_id": scorecardId, "id": id}
+	if _, err := do[okResponse](ctx, c, http.MethodPost, "scorecards.checks.delete", nil, payload); err != nil {
+		// The check or its scorecard is already gone, which is what the caller asked for
+		if IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
 	}
+	return true, nil
+}
 
-	setRequestHeaders(req, c)
-
-	tflog.Info(ctx, fmt.Sprintf("Request body:\n%s", string(body)))
+// APICheckEvaluation is the result of evaluating a check for one entity.
+type APICheckEvaluation struct {
+	EntityIdentifier string  `json:"entity_identifier"`
+	EntityName       *string `json:"entity_name"`
+	// Status is PASS, WARN or FAIL, or null when the entity was excluded by the check's filter.
+	Status *string `json:"status"`
+	// Output is the check's output for the entity, of the type given by the check's output_type.
+	Output  json.RawMessage `json:"output"`
+	Message *string         `json:"message"`
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("making HTTP request: %w", err)
-	}
-	defer resp.Body.Close()
+// APICheckEvaluationResponse is the top-level response from the DX API for the
+// scorecards.checks.evaluate endpoint.
+type APICheckEvaluationResponse struct {
+	Ok      bool                 `json:"ok"`
+	Results []APICheckEvaluation `json:"results"`
+}
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
+// EvaluateScorecardCheck runs a check's queries against a sample of entities without saving the
+// check. The payload holds the check's `sql`, `filter_sql` and output settings, and the entities
+// to evaluate: a `scorecard_id`, `entity_filter_type_identifiers` or `entity_filter_sql`.
+func (c *Client) EvaluateScorecardCheck(ctx context.Context, payload map[string]interface{}) (*APICheckEvaluationResponse, error) {
+	return do[APICheckEvaluationResponse](ctx, c, http.MethodPost, "scorecards.checks.evaluate", nil, payload)
+}
 
-		// format the JSON nicely
-		var prettyJSON bytes.Buffer
-		if err := json.Indent(&prettyJSON, body, "", "  "); err == nil {
-			tflog.Debug(ctx, fmt.Sprintf("Error response body:\n%s", prettyJSON.String()))
-		} else {
-			tflog.Debug(ctx, fmt.Sprintf("Error response body (raw):\n%s", string(body)))
-		}
+// APIScorecardResult is the current outcome of a scorecard for one entity.
+type APIScorecardResult struct {
+	EntityIdentifier string  `json:"entity_identifier"`
+	EntityName       *string `json:"entity_name"`
+	EntityType       string  `json:"entity_type"`
 
-		return nil, fmt.Errorf("unexpected status code: %d, response body: %s", resp.StatusCode, string(body))
-	}
+	// Level is the highest level the entity reached, or nil when it reached none (levels
+	// scorecards only).
+	Level *APILevel `json:"level"`
 
-	var apiResp APIResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, fmt.Errorf("decoding API response: %w", err)
-	}
+	// Points and PointsPossible are set for points scorecards only.
+	Points         *int32 `json:"points"`
+	PointsPossible *int32 `json:"points_possible"`
 
-	// Log the API response for debugging
-	if respJson, err := json.MarshalIndent(apiResp, "", "  "); err == nil {
-		tflog.Info(ctx, fmt.Sprintf("API Response from UpdateScorecard:\n%s", string(respJson)))
-	} else {
-		tflog.Debug(ctx, "Could not marshal API response", map[string]interface{}{
-			"error": err,
-		})
-	}
+	CheckResults []APICheckResult `json:"check_results"`
+}
 
-	return &apiResp, nil
+// APICheckResult is the outcome of one check for an entity. Status is PASS, WARN or FAIL, or nil
+// when the check's filter excludes the entity.
+type APICheckResult struct {
+	CheckId   string          `json:"check_id"`
+	CheckName *string         `json:"check_name"`
+	Status    *string         `json:"status"`
+	Output    json.RawMessage `json:"output"`
 }
 
-func (c *Client) DeleteScorecard(ctx context.Context, id string) (bool, error) {
-	tflog.Info(ctx, "Calling DeleteScorecard")
-	tflog.Info(ctx, fmt.Sprintf("Deleting scorecard with ID: %s", id))
+// APIScorecardResultsListResponse is the top-level response from the DX API for the
+// scorecards.results.list endpoint.
+type APIScorecardResultsListResponse struct {
+	Ok               bool                 `json:"ok"`
+	Results          []APIScorecardResult `json:"results"`
+	ResponseMetadata ResponseMetadata     `json:"response_metadata"`
+}
 
-	if os.Getenv("TF_ACC") == "1" {
-		// This is a workaround for a possible race condition where a scorecard might be evaluating scorecard checks.
-		tflog.Info(ctx, "Acceptance test environment detected, sleeping for 2 seconds before proceeding with delete...")
-		time.Sleep(2 * time.Second)
-	}
+// ListScorecardResultsOptions contains optional parameters for ListScorecardResults.
+type ListScorecardResultsOptions struct {
+	PageOptions
 
-	payload := map[string]interface{}{"id": id}
-	body, err := json.Marshal(payload)
-	if err != nil {
-		return false, fmt.Errorf("marshaling payload: %w", err)
-	}
+	EntityIdentifier *string // Only return the result of this entity.
+}
 
-	url := fmt.Sprintf("%s/scorecards.delete", c.baseURL)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
-	if err != nil {
-		return false, fmt.Errorf("creating request: %w", err)
+// IterateScorecardResults lazily lists the results of a scorecard, one per assessed entity,
+// requesting further pages only as the caller consumes them.
+func (c *Client) IterateScorecardResults(ctx context.Context, scorecardId string, opts *ListScorecardResultsOptions) iter.Seq2[APIScorecardResult, error] {
+	query := url.Values{"scorecard_id": {scorecardId}}
+	var pageOpts PageOptions
+	if opts != nil {
+		pageOpts = opts.PageOptions
+		if opts.EntityIdentifier != nil && *opts.EntityIdentifier != "" {
+			query.Set("entity_identifier", *opts.EntityIdentifier)
+		}
 	}
 
-	setRequestHeaders(req, c)
+	return paginate(ctx, c, "scorecards.results.list", query, pageOpts, func(resp *APIScorecardResultsListResponse) ([]APIScorecardResult, string) {
+		return resp.Results, resp.ResponseMetadata.NextCursor
+	})
+}
 
-	resp, err := c.httpClient.Do(req)
+// ListScorecardResults returns every result of a scorecard, see IterateScorecardResults.
+func (c *Client) ListScorecardResults(ctx context.Context, scorecardId string, opts *ListScorecardResultsOptions) ([]APIScorecardResult, error) {
+	results, err := collect(c.IterateScorecardResults(ctx, scorecardId, opts))
 	if err != nil {
-		return false, fmt.Errorf("making HTTP request: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return false, fmt.Errorf("unexpected status code: %d, response body: %s", resp.StatusCode, string(body))
-	}
-
-	return true, nil
-}
 
-func setRequestHeaders(req *http.Request, client *Client) {
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+client.token)
-	req.Header.Set("X-Client-Type", "terraform-provider-dx")
-	req.Header.Set("X-Client-Version", client.version)
+	tflog.Debug(ctx, "Listed scorecard results", map[string]interface{}{
+		"scorecard_id": scorecardId,
+		"count":        len(results),
+	})
+	return results, nil
 }
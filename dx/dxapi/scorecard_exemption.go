@@ -0,0 +1,89 @@
+package dxapi
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// APIExemption lets an entity skip a check of a scorecard. ExpiresAt is an RFC 3339 timestamp,
+// or nil for an exemption that never expires.
+type APIExemption struct {
+	Id               string  `json:"id"`
+	ScorecardId      string  `json:"scorecard_id"`
+	CheckId          string  `json:"check_id"`
+	EntityIdentifier string  `json:"entity_identifier"`
+	Reason           string  `json:"reason"`
+	ExpiresAt        *string `json:"expires_at"`
+	CreatedAt        string  `json:"created_at"`
+}
+
+type APIExemptionResponse struct {
+	Ok        bool         `json:"ok"`
+	Exemption APIExemption `json:"exemption"`
+}
+
+// APIExemptionsListResponse is the top-level response from the DX API for the
+// scorecards.checks.exemptions.list endpoint.
+type APIExemptionsListResponse struct {
+	Ok               bool             `json:"ok"`
+	Exemptions       []APIExemption   `json:"exemptions"`
+	ResponseMetadata ResponseMetadata `json:"response_metadata"`
+}
+
+// ListExemptionsOptions contains optional filters for IterateExemptions.
+type ListExemptionsOptions struct {
+	PageOptions
+
+	ScorecardId      string // Only exemptions from checks of this scorecard.
+	CheckId          string // Only exemptions from this check.
+	EntityIdentifier string // Only exemptions of this entity.
+}
+
+// IterateExemptions lazily lists the active exemptions, requesting further pages only as the
+// caller consumes them. Expired exemptions are not listed.
+func (c *Client) IterateExemptions(ctx context.Context, opts *ListExemptionsOptions) iter.Seq2[APIExemption, error] {
+	query := url.Values{}
+	var pageOpts PageOptions
+	if opts != nil {
+		pageOpts = opts.PageOptions
+		for name, value := range map[string]string{
+			"scorecard_id":      opts.ScorecardId,
+			"check_id":          opts.CheckId,
+			"entity_identifier": opts.EntityIdentifier,
+		} {
+			if value != "" {
+				query.Set(name, value)
+			}
+		}
+	}
+
+	return paginate(ctx, c, "scorecards.checks.exemptions.list", query, pageOpts, func(resp *APIExemptionsListResponse) ([]APIExemption, string) {
+		return resp.Exemptions, resp.ResponseMetadata.NextCursor
+	})
+}
+
+func (c *Client) CreateExemption(ctx context.Context, payload map[string]interface{}) (*APIExemptionResponse, error) {
+	return do[APIExemptionResponse](ctx, c, http.MethodPost, "scorecards.checks.exemptions.create", nil, payload)
+}
+
+// GetExemption returns an exemption, including after it expired.
+func (c *Client) GetExemption(ctx context.Context, id string) (*APIExemptionResponse, error) {
+	return do[APIExemptionResponse](ctx, c, http.MethodGet, "scorecards.checks.exemptions.info", url.Values{"id": {id}}, nil)
+}
+
+func (c *Client) UpdateExemption(ctx context.Context, payload map[string]interface{}) (*APIExemptionResponse, error) {
+	return do[APIExemptionResponse](ctx, c, http.MethodPost, "scorecards.checks.exemptions.update", nil, payload)
+}
+
+func (c *Client) DeleteExemption(ctx context.Context, id string) (bool, error) {
+	if _, err := do[okResponse](ctx, c, http.MethodPost, "scorecards.checks.exemptions.delete", nil, map[string]interface{}{"id": id}); err != nil {
+		// The exemption is already gone, which is what the caller asked for
+		if IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,64 @@
+package dxapi
+
+import (
+	"context"
+	"iter"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type APITeam struct {
+	Id       string  `json:"id"`
+	Name     string  `json:"name"`
+	ParentId *string `json:"parent_id"`
+}
+
+// APITeamsListResponse is the top-level response from the DX API for the teams.list endpoint.
+type APITeamsListResponse struct {
+	Ok               bool             `json:"ok"`
+	Teams            []APITeam        `json:"teams"`
+	ResponseMetadata ResponseMetadata `json:"response_metadata"`
+}
+
+// IterateTeams lazily lists every team, requesting further pages only as the caller consumes them.
+func (c *Client) IterateTeams(ctx context.Context, opts *PageOptions) iter.Seq2[APITeam, error] {
+	var pageOpts PageOptions
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	return paginate(ctx, c, "teams.list", url.Values{}, pageOpts, func(resp *APITeamsListResponse) ([]APITeam, string) {
+		return resp.Teams, resp.ResponseMetadata.NextCursor
+	})
+}
+
+// ListTeams returns every team, see IterateTeams.
+func (c *Client) ListTeams(ctx context.Context, opts *PageOptions) ([]APITeam, error) {
+	teams, err := collect(c.IterateTeams(ctx, opts))
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Listed teams", map[string]interface{}{
+		"count": len(teams),
+	})
+	return teams, nil
+}
+
+// FindTeamsByName returns the team with each of the given names, in the same order. Names are
+// matched exactly. If a name matches no team, or more than one, a *LookupError lists them.
+//
+// The teams are listed once for the lifetime of the client, i.e. once per Terraform run.
+func (c *Client) FindTeamsByName(ctx context.Context, names []string) ([]APITeam, error) {
+	teams, err := c.teams.load(func() ([]APITeam, error) {
+		return c.ListTeams(ctx, &PageOptions{PageSize: MaxPageSize})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lookup("team", names, teams, func(team APITeam, name string) bool {
+		return team.Name == name
+	})
+}
@@ -0,0 +1,44 @@
+package dxapi
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+// acquire blocks until the client may send another request, honouring both the rate limit and
+// the concurrency cap. The returned function releases the concurrency slot and must be called
+// exactly once when the request has finished.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+
+	if c.inFlight != nil {
+		select {
+		case c.inFlight <- struct{}{}:
+			release = sync.OnceFunc(func() { <-c.inFlight })
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
+
+// releaseOnClose keeps a concurrency slot held until the caller has finished reading the
+// response body, so slow downloads count towards the in-flight cap.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	defer r.release()
+	return r.ReadCloser.Close()
+}
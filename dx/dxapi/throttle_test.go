@@ -0,0 +1,62 @@
+package dxapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMaxConcurrencyCapsRequestsInFlight(t *testing.T) {
+	var current, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"ok": true, "entity": {"identifier": "svc", "type": "service"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, "token", "test", WithMaxConcurrency(2))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetEntity(context.Background(), "svc"); err != nil {
+				t.Errorf("GetEntity failed: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > 2 {
+		t.Errorf("Expected at most 2 concurrent requests, observed %d", got)
+	}
+}
+
+func TestAcquireHonoursContextCancellation(t *testing.T) {
+	client := NewClient("http://localhost", "token", "test", WithMaxConcurrency(1))
+
+	release, err := client.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("Expected first acquire to succeed, got: %s", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.acquire(ctx); err == nil {
+		t.Error("Expected acquire to fail once the context is done")
+	}
+}
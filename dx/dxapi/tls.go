@@ -0,0 +1,66 @@
+package dxapi
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// TLSOptions describes how the client verifies the DX API's certificate and authenticates itself,
+// e.g. for a deployment behind a private certificate authority or one that requires mutual TLS.
+type TLSOptions struct {
+	// CACertPEM is a PEM encoded certificate authority to trust in addition to the system roots.
+	CACertPEM string
+	// CABundleFile is the path to a file of PEM encoded certificate authorities to trust in
+	// addition to the system roots.
+	CABundleFile string
+	// ClientCertPEM and ClientKeyPEM are the PEM encoded certificate and private key presented to
+	// servers that require mutual TLS. Both must be set to use a client certificate.
+	ClientCertPEM string
+	ClientKeyPEM  string
+	// InsecureSkipVerify disables certificate verification entirely. Only meant for testing.
+	InsecureSkipVerify bool
+}
+
+// Config builds the tls.Config for these options.
+func (o TLSOptions) Config() (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: o.InsecureSkipVerify,
+	}
+
+	if o.CACertPEM != "" || o.CABundleFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if o.CACertPEM != "" && !pool.AppendCertsFromPEM([]byte(o.CACertPEM)) {
+			return nil, errors.New("no valid PEM encoded certificates found in the CA certificate")
+		}
+		if o.CABundleFile != "" {
+			bundle, err := os.ReadFile(o.CABundleFile)
+			if err != nil {
+				return nil, fmt.Errorf("reading CA bundle: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(bundle) {
+				return nil, fmt.Errorf("no valid PEM encoded certificates found in CA bundle %s", o.CABundleFile)
+			}
+		}
+		cfg.RootCAs = pool
+	}
+
+	if o.ClientCertPEM != "" || o.ClientKeyPEM != "" {
+		if o.ClientCertPEM == "" || o.ClientKeyPEM == "" {
+			return nil, errors.New("a client certificate requires both the certificate and the private key")
+		}
+		cert, err := tls.X509KeyPair([]byte(o.ClientCertPEM), []byte(o.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
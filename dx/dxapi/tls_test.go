@@ -0,0 +1,139 @@
+package dxapi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newClientCertificate returns a self-signed client certificate and its private key, PEM encoded.
+func newClientCertificate(t *testing.T) (*x509.Certificate, string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Generating key failed: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-provider-dx"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Creating certificate failed: %s", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Parsing certificate failed: %s", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Marshaling key failed: %s", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return cert, string(certPEM), string(keyPEM)
+}
+
+func TestMutualTLS(t *testing.T) {
+	clientCert, clientCertPEM, clientKeyPEM := newClientCertificate(t)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(entityResponse))
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	// Trust the test server through a CA bundle file, as a user would on disk
+	caBundleFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caBundleFile, caPEM, 0o600); err != nil {
+		t.Fatalf("Writing CA bundle failed: %s", err)
+	}
+
+	withoutCert, err := TLSOptions{CABundleFile: caBundleFile}.Config()
+	if err != nil {
+		t.Fatalf("Building TLS config failed: %s", err)
+	}
+	client := NewClient(server.URL, "token", "test", WithTLSConfig(withoutCert), WithRetry(RetryConfig{}))
+	if _, err := client.GetEntity(context.Background(), "svc"); err == nil {
+		t.Error("Expected the server to reject a client without a certificate")
+	}
+
+	withCert, err := TLSOptions{
+		CABundleFile:  caBundleFile,
+		ClientCertPEM: clientCertPEM,
+		ClientKeyPEM:  clientKeyPEM,
+	}.Config()
+	if err != nil {
+		t.Fatalf("Building TLS config failed: %s", err)
+	}
+	client = NewClient(server.URL, "token", "test", WithTLSConfig(withCert))
+	if _, err := client.GetEntity(context.Background(), "svc"); err != nil {
+		t.Errorf("Expected the client certificate to be accepted, got: %s", err)
+	}
+}
+
+func TestTLSOptionsRequiresCertificateAndKey(t *testing.T) {
+	_, clientCertPEM, _ := newClientCertificate(t)
+
+	if _, err := (TLSOptions{ClientCertPEM: clientCertPEM}).Config(); err == nil {
+		t.Error("Expected a client certificate without a key to be rejected")
+	}
+	if _, err := (TLSOptions{CABundleFile: filepath.Join(t.TempDir(), "missing.pem")}).Config(); err == nil {
+		t.Error("Expected a missing CA bundle file to be rejected")
+	}
+}
+
+func TestWithProxy(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A forward proxy receives the absolute URL of the upstream request
+		if r.URL.Host != "api.dx.invalid" {
+			t.Errorf("Expected the request for the DX API to reach the proxy, got %s", r.URL)
+		}
+		expectedAuth := "Basic " + base64.StdEncoding.EncodeToString([]byte("ci:secret"))
+		if got := r.Header.Get("Proxy-Authorization"); got != expectedAuth {
+			t.Errorf("Expected proxy credentials to be sent, got %q", got)
+		}
+		_, _ = w.Write([]byte(entityResponse))
+	}))
+	t.Cleanup(proxy.Close)
+
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatalf("Parsing proxy URL failed: %s", err)
+	}
+	proxyURL.User = url.UserPassword("ci", "secret")
+
+	client := NewClient("http://api.dx.invalid", "token", "test", WithProxy(proxyURL), WithRetry(RetryConfig{}))
+	if _, err := client.GetEntity(context.Background(), "svc"); err != nil {
+		t.Errorf("Expected the request to go through the proxy, got: %s", err)
+	}
+}
@@ -0,0 +1,65 @@
+package dxapi
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type APIUser struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// APIUsersListResponse is the top-level response from the DX API for the users.list endpoint.
+type APIUsersListResponse struct {
+	Ok               bool             `json:"ok"`
+	Users            []APIUser        `json:"users"`
+	ResponseMetadata ResponseMetadata `json:"response_metadata"`
+}
+
+// IterateUsers lazily lists every user, requesting further pages only as the caller consumes them.
+func (c *Client) IterateUsers(ctx context.Context, opts *PageOptions) iter.Seq2[APIUser, error] {
+	var pageOpts PageOptions
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	return paginate(ctx, c, "users.list", url.Values{}, pageOpts, func(resp *APIUsersListResponse) ([]APIUser, string) {
+		return resp.Users, resp.ResponseMetadata.NextCursor
+	})
+}
+
+// ListUsers returns every user, see IterateUsers.
+func (c *Client) ListUsers(ctx context.Context, opts *PageOptions) ([]APIUser, error) {
+	users, err := collect(c.IterateUsers(ctx, opts))
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Listed users", map[string]interface{}{
+		"count": len(users),
+	})
+	return users, nil
+}
+
+// FindUsersByEmail returns the user with each of the given emails, in the same order. Emails are
+// matched ignoring case. If an email matches no user, or more than one, a *LookupError lists them.
+//
+// The users are listed once for the lifetime of the client, i.e. once per Terraform run.
+func (c *Client) FindUsersByEmail(ctx context.Context, emails []string) ([]APIUser, error) {
+	users, err := c.users.load(func() ([]APIUser, error) {
+		return c.ListUsers(ctx, &PageOptions{PageSize: MaxPageSize})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lookup("user", emails, users, func(user APIUser, email string) bool {
+		return strings.EqualFold(user.Email, email)
+	})
+}
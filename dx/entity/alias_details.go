@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"context"
+
+	"terraform-provider-dx/dx"
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// aliasDetailsType is the type of an alias with the name and URL that DX resolved for it.
+var aliasDetailsType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"identifier":          types.StringType,
+		"instance_identifier": types.StringType,
+		"name":                types.StringType,
+		"url":                 types.StringType,
+	},
+}
+
+// aliasDetailsFromAPI maps the entity's aliases, including their resolved name and URL.
+func aliasDetailsFromAPI(aliases map[string][]dxapi.APIAlias) map[string][]AliasDetailsModel {
+	details := make(map[string][]AliasDetailsModel, len(aliases))
+	for aliasType, aliasArray := range aliases {
+		if len(aliasArray) == 0 {
+			continue
+		}
+		models := make([]AliasDetailsModel, 0, len(aliasArray))
+		for _, alias := range aliasArray {
+			models = append(models, AliasDetailsModel{
+				Identifier:         types.StringValue(alias.Identifier),
+				InstanceIdentifier: dx.StringOrNull(alias.InstanceIdentifier),
+				Name:               dx.StringOrNullConvertEmpty(alias.Name),
+				Url:                dx.StringOrNullConvertEmpty(alias.Url),
+			})
+		}
+		details[aliasType] = models
+	}
+	return details
+}
+
+// planAliasDetails keeps the alias details from the prior state while the configured aliases are
+// unchanged. Otherwise they are unknown until the API has resolved the new aliases. State written
+// before `alias_details` existed has none, so they are unknown until Read or an apply fills them
+// in, which a refresh does before every plan.
+func planAliasDetails(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if req.State.Raw.IsNull() {
+		return diags
+	}
+
+	var planAliases, stateAliases types.Map
+	var stateDetails types.Map
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("aliases"), &planAliases)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("aliases"), &stateAliases)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("alias_details"), &stateDetails)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	details := types.MapUnknown(types.ListType{ElemType: aliasDetailsType})
+	if planAliases.Equal(stateAliases) && !stateDetails.IsNull() {
+		details = stateDetails
+	}
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("alias_details"), details)...)
+	return diags
+}
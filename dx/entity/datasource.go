@@ -33,78 +33,76 @@ func (d *EntityDataSource) Metadata(ctx context.Context, req datasource.Metadata
 }
 
 func (d *EntityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "Reads a DX Entity from the catalog. Use this to reference existing entities without managing them.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Computed:    true,
-				Description: "The unique identifier of the entity (same as 'identifier').",
-			},
-			"identifier": schema.StringAttribute{
-				Required:    true,
-				Description: "The unique identifier of the entity to look up.",
-			},
-			"type": schema.StringAttribute{
-				Computed:    true,
-				Description: "The identifier of the entity type (e.g., 'service', 'api', 'domain').",
-			},
-			"name": schema.StringAttribute{
-				Computed:    true,
-				Description: "Display name for the entity.",
-			},
-			"description": schema.StringAttribute{
-				Computed:    true,
-				Description: "Description of the entity.",
-			},
-			"owner_teams": schema.ListAttribute{
-				ElementType: types.ObjectType{
-					AttrTypes: map[string]attr.Type{
-						"id":   types.StringType,
-						"name": types.StringType,
-					},
-				},
-				Computed:    true,
-				Description: "Array of owner teams assigned to the entity. Each team has 'id' and 'name' fields.",
-			},
-			"owner_users": schema.ListAttribute{
-				ElementType: types.ObjectType{
-					AttrTypes: map[string]attr.Type{
-						"id":    types.StringType,
-						"email": types.StringType,
-					},
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the entity (same as 'identifier').",
+		},
+		"identifier": schema.StringAttribute{
+			Required:    true,
+			Description: "The unique identifier of the entity to look up.",
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The identifier of the entity type (e.g., 'service', 'api', 'domain').",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Display name for the entity.",
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: "Description of the entity.",
+		},
+		"owner_teams": schema.ListAttribute{
+			ElementType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"id":   types.StringType,
+					"name": types.StringType,
 				},
-				Computed:    true,
-				Description: "Array of owner users assigned to the entity. Each user has 'id' and 'email' fields.",
-			},
-			"domain": schema.StringAttribute{
-				Computed:    true,
-				Description: "The identifier of the domain entity parent assigned to the entity.",
 			},
-			"properties": schema.DynamicAttribute{
-				Computed:    true,
-				Description: "Key-value pairs of entity properties and their values. Values can be strings, numbers, null, objects, or lists of any of those types.",
-			},
-			"aliases": schema.MapAttribute{
-				ElementType: types.ListType{
-					ElemType: types.ObjectType{
-						AttrTypes: map[string]attr.Type{
-							"identifier":          types.StringType,
-							"instance_identifier": types.StringType,
-						},
-					},
+			Computed:    true,
+			Description: "Array of owner teams assigned to the entity. Each team has 'id' and 'name' fields.",
+		},
+		"owner_users": schema.ListAttribute{
+			ElementType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"id":    types.StringType,
+					"email": types.StringType,
 				},
-				Computed:    true,
-				Description: "Key-value pairs of aliases assigned to the entity. Keys are alias types (e.g., 'github_repo'), values are arrays of alias objects with 'identifier' and optional 'instance_identifier' fields.",
-			},
-			"created_at": schema.StringAttribute{
-				Computed:    true,
-				Description: "Timestamp when the entity was created.",
-			},
-			"updated_at": schema.StringAttribute{
-				Computed:    true,
-				Description: "Timestamp when the entity was last updated.",
 			},
+			Computed:    true,
+			Description: "Array of owner users assigned to the entity. Each user has 'id' and 'email' fields.",
+		},
+		"domain": schema.StringAttribute{
+			Computed:    true,
+			Description: "The identifier of the domain entity parent assigned to the entity.",
+		},
+		"properties": schema.DynamicAttribute{
+			Computed:    true,
+			Description: "Key-value pairs of entity properties and their values. Values can be strings, numbers, null, objects, or lists of any of those types.",
 		},
+		"aliases": schema.MapAttribute{
+			ElementType: types.ListType{ElemType: aliasDetailsType},
+			Computed:    true,
+			Description: "Key-value pairs of aliases assigned to the entity. Keys are alias types (e.g., 'github_repo'), values are arrays of alias objects with 'identifier', optional 'instance_identifier', and the 'name' and 'url' DX resolved for the alias from the Data Cloud database.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timestamp when the entity was created.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timestamp when the entity was last updated.",
+		},
+	}
+	for name, attribute := range typedPropertiesDataSourceSchema() {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reads a DX Entity from the catalog. Use this to reference existing entities without managing them.",
+		Attributes:  attributes,
 	}
 }
 
@@ -160,9 +158,15 @@ func (d *EntityDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	// The entity type's property definitions sort the properties into the typed maps
+	entityType, err := d.client.CachedEntityType(ctx, apiResp.Entity.Type)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Could not read entity type %s, typed properties are sorted by value: %s", apiResp.Entity.Type, err.Error()))
+	}
+
 	// Map API response to data source model
 	var state EntityDataSourceModel
-	mapAPIResponseToDataSourceModel(ctx, apiResp, &state)
+	mapAPIResponseToDataSourceModel(ctx, apiResp, entityType, &state)
 
 	// Set state
 	diags = resp.State.Set(ctx, &state)
@@ -171,18 +175,20 @@ func (d *EntityDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 // EntityDataSourceModel describes the data source data model.
 type EntityDataSourceModel struct {
-	Id          types.String            `tfsdk:"id"`
-	Identifier  types.String            `tfsdk:"identifier"`
-	Type        types.String            `tfsdk:"type"`
-	Name        types.String            `tfsdk:"name"`
-	Description types.String            `tfsdk:"description"`
-	OwnerTeams  []OwnerTeamModel        `tfsdk:"owner_teams"`
-	OwnerUsers  []OwnerUserModel        `tfsdk:"owner_users"`
-	Domain      types.String            `tfsdk:"domain"`
-	Properties  types.Dynamic           `tfsdk:"properties"`
-	Aliases     map[string][]AliasModel `tfsdk:"aliases"`
-	CreatedAt   types.String            `tfsdk:"created_at"`
-	UpdatedAt   types.String            `tfsdk:"updated_at"`
+	Id          types.String                   `tfsdk:"id"`
+	Identifier  types.String                   `tfsdk:"identifier"`
+	Type        types.String                   `tfsdk:"type"`
+	Name        types.String                   `tfsdk:"name"`
+	Description types.String                   `tfsdk:"description"`
+	OwnerTeams  []OwnerTeamModel               `tfsdk:"owner_teams"`
+	OwnerUsers  []OwnerUserModel               `tfsdk:"owner_users"`
+	Domain      types.String                   `tfsdk:"domain"`
+	Properties  types.Dynamic                  `tfsdk:"properties"`
+	Aliases     map[string][]AliasDetailsModel `tfsdk:"aliases"`
+	CreatedAt   types.String                   `tfsdk:"created_at"`
+	UpdatedAt   types.String                   `tfsdk:"updated_at"`
+
+	TypedPropertiesModel
 }
 
 // OwnerTeamModel describes an owner team.
@@ -197,8 +203,9 @@ type OwnerUserModel struct {
 	Email types.String `tfsdk:"email"`
 }
 
-// mapAPIResponseToDataSourceModel converts API response to data source model.
-func mapAPIResponseToDataSourceModel(ctx context.Context, apiResp *dxapi.APIEntityResponse, state *EntityDataSourceModel) {
+// mapAPIResponseToDataSourceModel converts API response to data source model. entityType may be
+// nil if it could not be read.
+func mapAPIResponseToDataSourceModel(ctx context.Context, apiResp *dxapi.APIEntityResponse, entityType *dxapi.APIEntityType, state *EntityDataSourceModel) {
 	tflog.Debug(ctx, "Mapping API response to data source model")
 
 	// Required fields
@@ -266,30 +273,11 @@ func mapAPIResponseToDataSourceModel(ctx context.Context, apiResp *dxapi.APIEnti
 	} else {
 		state.Properties = types.DynamicNull()
 	}
+	state.TypedPropertiesModel = typedPropertiesFromAPI(entityType, apiResp.Entity.Properties)
 
-	// Aliases - convert from API response to map[string][]AliasModel
+	// Aliases - convert from API response to map[string][]AliasDetailsModel
 	if len(apiResp.Entity.Aliases) > 0 {
-		aliases := make(map[string][]AliasModel)
-		for aliasType, aliasArray := range apiResp.Entity.Aliases {
-			aliasModels := make([]AliasModel, 0, len(aliasArray))
-			for _, alias := range aliasArray {
-				var instanceID types.String
-				if alias.InstanceIdentifier != nil {
-					instanceID = types.StringValue(*alias.InstanceIdentifier)
-				} else {
-					instanceID = types.StringNull()
-				}
-				aliasModel := AliasModel{
-					Identifier:         types.StringValue(alias.Identifier),
-					InstanceIdentifier: instanceID,
-				}
-				aliasModels = append(aliasModels, aliasModel)
-			}
-			if len(aliasModels) > 0 {
-				aliases[aliasType] = aliasModels
-			}
-		}
-		state.Aliases = aliases
+		state.Aliases = aliasDetailsFromAPI(apiResp.Entity.Aliases)
 	} else {
 		state.Aliases = nil
 	}
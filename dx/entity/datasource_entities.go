@@ -7,9 +7,11 @@ import (
 
 	"terraform-provider-dx/dx/dxapi"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -30,22 +32,25 @@ type EntitiesDataSource struct {
 type EntitiesDataSourceModel struct {
 	Type       types.String          `tfsdk:"type"`
 	SearchTerm types.String          `tfsdk:"search_term"`
+	Limit      types.Int64           `tfsdk:"limit"`
 	Entities   []EntitiesEntityModel `tfsdk:"entities"`
 }
 
 type EntitiesEntityModel struct {
-	Id          types.String            `tfsdk:"id"`
-	Identifier  types.String            `tfsdk:"identifier"`
-	Type        types.String            `tfsdk:"type"`
-	Name        types.String            `tfsdk:"name"`
-	Description types.String            `tfsdk:"description"`
-	OwnerTeams  []OwnerTeamModel        `tfsdk:"owner_teams"`
-	OwnerUsers  []OwnerUserModel        `tfsdk:"owner_users"`
-	Domain      types.String            `tfsdk:"domain"`
-	Properties  types.String            `tfsdk:"properties"`
-	Aliases     map[string][]AliasModel `tfsdk:"aliases"`
-	CreatedAt   types.String            `tfsdk:"created_at"`
-	UpdatedAt   types.String            `tfsdk:"updated_at"`
+	Id          types.String                   `tfsdk:"id"`
+	Identifier  types.String                   `tfsdk:"identifier"`
+	Type        types.String                   `tfsdk:"type"`
+	Name        types.String                   `tfsdk:"name"`
+	Description types.String                   `tfsdk:"description"`
+	OwnerTeams  []OwnerTeamModel               `tfsdk:"owner_teams"`
+	OwnerUsers  []OwnerUserModel               `tfsdk:"owner_users"`
+	Domain      types.String                   `tfsdk:"domain"`
+	Properties  types.String                   `tfsdk:"properties"`
+	Aliases     map[string][]AliasDetailsModel `tfsdk:"aliases"`
+	CreatedAt   types.String                   `tfsdk:"created_at"`
+	UpdatedAt   types.String                   `tfsdk:"updated_at"`
+
+	TypedPropertiesModel
 }
 
 func (d *EntitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -53,6 +58,73 @@ func (d *EntitiesDataSource) Metadata(ctx context.Context, req datasource.Metada
 }
 
 func (d *EntitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	entityAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the entity (same as 'identifier').",
+		},
+		"identifier": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the entity.",
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The identifier of the entity type.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Display name for the entity.",
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: "Description of the entity.",
+		},
+		"owner_teams": schema.ListAttribute{
+			ElementType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"id":   types.StringType,
+					"name": types.StringType,
+				},
+			},
+			Computed:    true,
+			Description: "Array of owner teams assigned to the entity.",
+		},
+		"owner_users": schema.ListAttribute{
+			ElementType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"id":    types.StringType,
+					"email": types.StringType,
+				},
+			},
+			Computed:    true,
+			Description: "Array of owner users assigned to the entity.",
+		},
+		"domain": schema.StringAttribute{
+			Computed:    true,
+			Description: "The identifier of the domain entity parent assigned to the entity.",
+		},
+		"properties": schema.StringAttribute{
+			Computed:    true,
+			Description: "JSON-encoded key-value pairs of entity properties. Use jsondecode() to access values.",
+		},
+		"aliases": schema.MapAttribute{
+			ElementType: types.ListType{ElemType: aliasDetailsType},
+			Computed:    true,
+			Description: "Key-value pairs of aliases assigned to the entity. Each alias object has 'identifier', 'instance_identifier', and the resolved 'name' and 'url'.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timestamp when the entity was created.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timestamp when the entity was last updated.",
+		},
+	}
+	for name, attribute := range typedPropertiesDataSourceSchema() {
+		entityAttributes[name] = attribute
+	}
+
 	resp.Schema = schema.Schema{
 		Description: "Lists all DX entities of a given type from the catalog.",
 		Attributes: map[string]schema.Attribute{
@@ -64,80 +136,18 @@ func (d *EntitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Optional:    true,
 				Description: "Filter entities by search term.",
 			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of entities to return. Listing stops as soon as this many entities have been read, which is much faster for entity types with many entities. All matching entities are returned if not set.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"entities": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of entities matching the given type.",
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							Computed:    true,
-							Description: "The unique identifier of the entity (same as 'identifier').",
-						},
-						"identifier": schema.StringAttribute{
-							Computed:    true,
-							Description: "The unique identifier of the entity.",
-						},
-						"type": schema.StringAttribute{
-							Computed:    true,
-							Description: "The identifier of the entity type.",
-						},
-						"name": schema.StringAttribute{
-							Computed:    true,
-							Description: "Display name for the entity.",
-						},
-						"description": schema.StringAttribute{
-							Computed:    true,
-							Description: "Description of the entity.",
-						},
-						"owner_teams": schema.ListAttribute{
-							ElementType: types.ObjectType{
-								AttrTypes: map[string]attr.Type{
-									"id":   types.StringType,
-									"name": types.StringType,
-								},
-							},
-							Computed:    true,
-							Description: "Array of owner teams assigned to the entity.",
-						},
-						"owner_users": schema.ListAttribute{
-							ElementType: types.ObjectType{
-								AttrTypes: map[string]attr.Type{
-									"id":    types.StringType,
-									"email": types.StringType,
-								},
-							},
-							Computed:    true,
-							Description: "Array of owner users assigned to the entity.",
-						},
-						"domain": schema.StringAttribute{
-							Computed:    true,
-							Description: "The identifier of the domain entity parent assigned to the entity.",
-						},
-						"properties": schema.StringAttribute{
-							Computed:    true,
-							Description: "JSON-encoded key-value pairs of entity properties. Use jsondecode() to access values.",
-						},
-						"aliases": schema.MapAttribute{
-							ElementType: types.ListType{
-								ElemType: types.ObjectType{
-									AttrTypes: map[string]attr.Type{
-										"identifier":          types.StringType,
-										"instance_identifier": types.StringType,
-									},
-								},
-							},
-							Computed:    true,
-							Description: "Key-value pairs of aliases assigned to the entity.",
-						},
-						"created_at": schema.StringAttribute{
-							Computed:    true,
-							Description: "Timestamp when the entity was created.",
-						},
-						"updated_at": schema.StringAttribute{
-							Computed:    true,
-							Description: "Timestamp when the entity was last updated.",
-						},
-					},
+					Attributes: entityAttributes,
 				},
 			},
 		},
@@ -182,32 +192,39 @@ func (d *EntitiesDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	// Build options from config
-	var opts *dxapi.ListEntitiesOptions
+	opts := &dxapi.ListEntitiesOptions{}
 	if !config.SearchTerm.IsNull() {
 		searchTerm := config.SearchTerm.ValueString()
-		opts = &dxapi.ListEntitiesOptions{
-			SearchTerm: &searchTerm,
-		}
+		opts.SearchTerm = &searchTerm
+	}
+	if !config.Limit.IsNull() {
+		opts.MaxResults = int(config.Limit.ValueInt64())
 	}
 
-	apiEntities, err := d.client.ListEntities(ctx, entityType, opts)
+	// The entity type's property definitions sort the properties into the typed maps
+	apiEntityType, err := d.client.CachedEntityType(ctx, entityType)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error listing entities",
-			fmt.Sprintf("Could not list entities of type %s: %s", entityType, err.Error()),
-		)
-		return
+		tflog.Warn(ctx, fmt.Sprintf("Could not read entity type %s, typed properties are sorted by value: %s", entityType, err.Error()))
 	}
 
 	state := EntitiesDataSourceModel{
 		Type:       config.Type,
 		SearchTerm: config.SearchTerm,
-		Entities:   make([]EntitiesEntityModel, 0, len(apiEntities)),
+		Limit:      config.Limit,
+		Entities:   []EntitiesEntityModel{},
 	}
 
-	for i := range apiEntities {
+	for apiEntity, err := range d.client.IterateEntities(ctx, entityType, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error listing entities",
+				fmt.Sprintf("Could not list entities of type %s: %s", entityType, err.Error()),
+			)
+			return
+		}
+
 		var entityModel EntitiesEntityModel
-		mapAPIEntityToEntitiesModel(ctx, &apiEntities[i], &entityModel)
+		mapAPIEntityToEntitiesModel(ctx, &apiEntity, apiEntityType, &entityModel)
 		state.Entities = append(state.Entities, entityModel)
 	}
 
@@ -217,8 +234,8 @@ func (d *EntitiesDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 // mapAPIEntityToEntitiesModel converts an APIEntity to the EntitiesEntityModel,
 // encoding properties as a JSON string since Dynamic types cannot be nested
-// inside collection attributes.
-func mapAPIEntityToEntitiesModel(ctx context.Context, entity *dxapi.APIEntity, state *EntitiesEntityModel) {
+// inside collection attributes. entityType may be nil if it could not be read.
+func mapAPIEntityToEntitiesModel(ctx context.Context, entity *dxapi.APIEntity, entityType *dxapi.APIEntityType, state *EntitiesEntityModel) {
 	state.Id = types.StringValue(entity.Identifier)
 	state.Identifier = types.StringValue(entity.Identifier)
 	state.Type = types.StringValue(entity.Type)
@@ -278,28 +295,10 @@ func mapAPIEntityToEntitiesModel(ctx context.Context, entity *dxapi.APIEntity, s
 	} else {
 		state.Properties = types.StringNull()
 	}
+	state.TypedPropertiesModel = typedPropertiesFromAPI(entityType, entity.Properties)
 
 	if len(entity.Aliases) > 0 {
-		aliases := make(map[string][]AliasModel)
-		for aliasType, aliasArray := range entity.Aliases {
-			aliasModels := make([]AliasModel, 0, len(aliasArray))
-			for _, alias := range aliasArray {
-				var instanceID types.String
-				if alias.InstanceIdentifier != nil {
-					instanceID = types.StringValue(*alias.InstanceIdentifier)
-				} else {
-					instanceID = types.StringNull()
-				}
-				aliasModels = append(aliasModels, AliasModel{
-					Identifier:         types.StringValue(alias.Identifier),
-					InstanceIdentifier: instanceID,
-				})
-			}
-			if len(aliasModels) > 0 {
-				aliases[aliasType] = aliasModels
-			}
-		}
-		state.Aliases = aliases
+		state.Aliases = aliasDetailsFromAPI(entity.Aliases)
 	} else {
 		state.Aliases = nil
 	}
@@ -66,9 +66,14 @@ func TestAccDxEntityDataSourceWithProperties(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.dx_entity.test_props", "created_at"),
 					resource.TestCheckResourceAttrSet("data.dx_entity.test_props", "updated_at"),
 
-					// Note: properties (Dynamic) and aliases (complex Map) can't be easily checked
-					// with TestCheckResourceAttr* functions. The fact that the config applies
-					// successfully and produces output verifies they're working correctly.
+					// Aliases include the name and URL DX resolved for them
+					resource.TestCheckResourceAttr("data.dx_entity.test_props", "aliases.github_repo.0.identifier", "520637360"),
+					resource.TestCheckResourceAttrSet("data.dx_entity.test_props", "aliases.github_repo.0.url"),
+					resource.TestCheckResourceAttrPair("data.dx_entity.test_props", "aliases.github_repo.0.url", "dx_entity.test_props", "alias_details.github_repo.0.url"),
+
+					// Note: properties (Dynamic) can't be easily checked with TestCheckResourceAttr*
+					// functions. The fact that the config applies successfully and produces output
+					// verifies it's working correctly.
 				),
 			},
 		},
@@ -90,6 +95,7 @@ func TestAccDxEntitiesDataSource(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.dx_entities.test", "type", "service"),
 					resource.TestCheckResourceAttrSet("data.dx_entities.test", "entities.#"),
+					resource.TestCheckResourceAttr("data.dx_entities.limited", "entities.#", "1"),
 				),
 			},
 		},
@@ -119,6 +125,13 @@ data "dx_entities" "test" {
 
   depends_on = [dx_entity.test1, dx_entity.test2]
 }
+
+data "dx_entities" "limited" {
+  type  = "service"
+  limit = 1
+
+  depends_on = [dx_entity.test1, dx_entity.test2]
+}
 `, identifier1, name1, identifier2, name2)
 }
 
@@ -76,12 +76,18 @@ func (r *EntityResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// Resolve owners whose names or emails were not known at plan time
+	resp.Diagnostics.Append(resolveOwners(ctx, r.client, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	payload := modelToRequestBody(ctx, plan)
 
 	// Create Entity (apiResp is a struct of type APIEntityResponse)
 	apiResp, err := r.client.CreateEntity(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating entity", err.Error())
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error creating entity", err)
 		return
 	}
 
@@ -111,8 +117,6 @@ func (r *EntityResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	tflog.Info(ctx, fmt.Sprintf("Prior state, before reading from API: %v", state))
-
 	// Extract identifier
 	identifier := state.Identifier.ValueString()
 	if identifier == "" {
@@ -123,6 +127,11 @@ func (r *EntityResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// Call the API to get the latest entity data
 	apiResp, err := r.client.GetEntity(ctx, identifier)
 	if err != nil {
+		if dxapi.IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Entity %s not found, removing from state", identifier))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading entity",
 			fmt.Sprintf("Could not read entity with identifier %s: %s", identifier, err.Error()),
@@ -159,6 +168,12 @@ func (r *EntityResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// Resolve owners whose names or emails were not known at plan time
+	resp.Diagnostics.Append(resolveOwners(ctx, r.client, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	payload := modelToRequestBody(ctx, plan)
 
 	// Add empty arrays for removed relation types so the API removes them
@@ -166,7 +181,7 @@ func (r *EntityResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	apiResp, err := r.client.UpdateEntity(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating entity", err.Error())
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error updating entity", err)
 		return
 	}
 
@@ -197,7 +212,7 @@ func (r *EntityResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	success, err := r.client.DeleteEntity(ctx, identifier)
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting entity", err.Error())
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error deleting entity", err)
 		return
 	}
 	if !success {
@@ -217,9 +232,7 @@ func (r *EntityResource) ImportState(ctx context.Context, req resource.ImportSta
 // nullFieldStates tracks which optional map/list fields were null in the plan.
 // This is needed because Go maps/slices lose the null vs empty distinction when decoded.
 type nullFieldStates struct {
-	AliasesNull      bool
-	OwnerTeamIdsNull bool
-	OwnerUserIdsNull bool
+	AliasesNull bool
 }
 
 // planGetter is an interface for getting attributes from a plan or state.
@@ -235,14 +248,6 @@ func checkNullStates(ctx context.Context, plan planGetter) nullFieldStates {
 	plan.GetAttribute(ctx, path.Root("aliases"), &aliasesAttr)
 	states.AliasesNull = aliasesAttr.IsNull()
 
-	var ownerTeamIdsAttr types.List
-	plan.GetAttribute(ctx, path.Root("owner_team_ids"), &ownerTeamIdsAttr)
-	states.OwnerTeamIdsNull = ownerTeamIdsAttr.IsNull()
-
-	var ownerUserIdsAttr types.List
-	plan.GetAttribute(ctx, path.Root("owner_user_ids"), &ownerUserIdsAttr)
-	states.OwnerUserIdsNull = ownerUserIdsAttr.IsNull()
-
 	return states
 }
 
@@ -253,12 +258,6 @@ func restoreNullStates(model *EntityResourceModel, states nullFieldStates) {
 	if states.AliasesNull && len(model.Aliases) == 0 {
 		model.Aliases = nil
 	}
-	if states.OwnerTeamIdsNull && len(model.OwnerTeamIds) == 0 {
-		model.OwnerTeamIds = nil
-	}
-	if states.OwnerUserIdsNull && len(model.OwnerUserIds) == 0 {
-		model.OwnerUserIds = nil
-	}
 }
 
 // addRemovedMapKeys adds empty arrays for alias types that existed in
@@ -309,6 +308,24 @@ func addRemovedMapKeys(_ context.Context, payload map[string]interface{}, priorS
 		}
 	}
 
+	// Properties may also be set in the typed property maps
+	if typed := typedPropertiesToGoValues(priorState.TypedPropertiesModel); len(typed) > 0 {
+		if priorProps == nil {
+			priorProps = make(map[string]interface{}, len(typed))
+		}
+		for key, value := range typed {
+			priorProps[key] = value
+		}
+	}
+	if typed := typedPropertiesToGoValues(plan.TypedPropertiesModel); len(typed) > 0 {
+		if planProps == nil {
+			planProps = make(map[string]interface{}, len(typed))
+		}
+		for key, value := range typed {
+			planProps[key] = value
+		}
+	}
+
 	if len(priorProps) > 0 {
 		// Get or create the properties map in payload
 		properties, ok := payload["properties"].(map[string]interface{})
@@ -358,6 +375,7 @@ func modelToRequestBody(ctx context.Context, plan EntityResourceModel) map[strin
 	addOwnerUserIdsToPayload(payload, plan)
 	addDomainToPayload(payload, plan)
 	addPropertiesToPayload(ctx, payload, plan)
+	addTypedPropertiesToPayload(payload, plan)
 	addAliasesToPayload(payload, plan)
 
 	return payload
@@ -379,31 +397,15 @@ func addDescriptionToPayload(payload map[string]interface{}, plan EntityResource
 
 // addOwnerTeamIdsToPayload adds the owner_team_ids array to the payload if set.
 func addOwnerTeamIdsToPayload(payload map[string]interface{}, plan EntityResourceModel) {
-	if len(plan.OwnerTeamIds) > 0 {
-		teamIds := make([]string, 0, len(plan.OwnerTeamIds))
-		for _, teamId := range plan.OwnerTeamIds {
-			if !teamId.IsNull() && !teamId.IsUnknown() {
-				teamIds = append(teamIds, teamId.ValueString())
-			}
-		}
-		if len(teamIds) > 0 {
-			payload["owner_team_ids"] = teamIds
-		}
+	if teamIds, _ := listStrings(plan.OwnerTeamIds); len(teamIds) > 0 {
+		payload["owner_team_ids"] = teamIds
 	}
 }
 
 // addOwnerUserIdsToPayload adds the owner_user_ids array to the payload if set.
 func addOwnerUserIdsToPayload(payload map[string]interface{}, plan EntityResourceModel) {
-	if len(plan.OwnerUserIds) > 0 {
-		userIds := make([]string, 0, len(plan.OwnerUserIds))
-		for _, userId := range plan.OwnerUserIds {
-			if !userId.IsNull() && !userId.IsUnknown() {
-				userIds = append(userIds, userId.ValueString())
-			}
-		}
-		if len(userIds) > 0 {
-			payload["owner_user_ids"] = userIds
-		}
+	if userIds, _ := listStrings(plan.OwnerUserIds); len(userIds) > 0 {
+		payload["owner_user_ids"] = userIds
 	}
 }
 
@@ -430,6 +432,24 @@ func addPropertiesToPayload(ctx context.Context, payload map[string]interface{},
 	}
 }
 
+// addTypedPropertiesToPayload merges the properties from the typed property maps into the
+// payload's properties.
+func addTypedPropertiesToPayload(payload map[string]interface{}, plan EntityResourceModel) {
+	typed := typedPropertiesToGoValues(plan.TypedPropertiesModel)
+	if len(typed) == 0 {
+		return
+	}
+
+	properties, ok := payload["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{}, len(typed))
+	}
+	for key, value := range typed {
+		properties[key] = value
+	}
+	payload["properties"] = properties
+}
+
 // addAliasesToPayload converts and adds aliases from map[string][]AliasModel to the payload.
 func addAliasesToPayload(payload map[string]interface{}, plan EntityResourceModel) {
 	if len(plan.Aliases) > 0 {
@@ -471,28 +491,22 @@ func responseBodyToModel(ctx context.Context, apiResp *dxapi.APIEntityResponse,
 	state.Description = dx.StringOrNull(apiResp.Entity.Description)
 
 	// Owner teams
-	if len(apiResp.Entity.OwnerTeams) > 0 {
-		teamIds := make([]types.String, 0, len(apiResp.Entity.OwnerTeams))
-		for _, team := range apiResp.Entity.OwnerTeams {
-			teamIds = append(teamIds, types.StringValue(team.Id))
-		}
-		state.OwnerTeamIds = teamIds
-	} else {
-		// API returned no owner teams - preserve exactly what was in the plan
-		state.OwnerTeamIds = oldPlan.OwnerTeamIds
+	teamIds := make([]string, 0, len(apiResp.Entity.OwnerTeams))
+	teamNames := make([]string, 0, len(apiResp.Entity.OwnerTeams))
+	for _, team := range apiResp.Entity.OwnerTeams {
+		teamIds = append(teamIds, team.Id)
+		teamNames = append(teamNames, team.Name)
 	}
+	ownersFromAPI(teamOwners, state, oldPlan, teamIds, teamNames)
 
 	// Owner users
-	if len(apiResp.Entity.OwnerUsers) > 0 {
-		userIds := make([]types.String, 0, len(apiResp.Entity.OwnerUsers))
-		for _, user := range apiResp.Entity.OwnerUsers {
-			userIds = append(userIds, types.StringValue(user.Id))
-		}
-		state.OwnerUserIds = userIds
-	} else {
-		// API returned no owner users - preserve exactly what was in the plan
-		state.OwnerUserIds = oldPlan.OwnerUserIds
+	userIds := make([]string, 0, len(apiResp.Entity.OwnerUsers))
+	userEmails := make([]string, 0, len(apiResp.Entity.OwnerUsers))
+	for _, user := range apiResp.Entity.OwnerUsers {
+		userIds = append(userIds, user.Id)
+		userEmails = append(userEmails, user.Email)
 	}
+	ownersFromAPI(userOwners, state, oldPlan, userIds, userEmails)
 
 	// Domain
 	if apiResp.Entity.Domain != nil {
@@ -501,10 +515,14 @@ func responseBodyToModel(ctx context.Context, apiResp *dxapi.APIEntityResponse,
 		state.Domain = types.StringNull()
 	}
 
+	// Typed properties - properties set in a typed map go back to that map, the rest go to properties
+	typedProperties, untypedProperties := splitTypedProperties(oldPlan.TypedPropertiesModel, apiResp.Entity.Properties)
+	state.TypedPropertiesModel = typedProperties
+
 	// Properties - convert from API response to types.Dynamic without validation
 	// Use JSON round-trip to convert to Dynamic value
-	if len(apiResp.Entity.Properties) > 0 {
-		jsonBytes, err := json.Marshal(apiResp.Entity.Properties)
+	if len(untypedProperties) > 0 {
+		jsonBytes, err := json.Marshal(untypedProperties)
 		if err == nil {
 			var normalized interface{}
 			if err := json.Unmarshal(jsonBytes, &normalized); err == nil {
@@ -563,6 +581,13 @@ func responseBodyToModel(ctx context.Context, apiResp *dxapi.APIEntityResponse,
 		state.Aliases = oldPlan.Aliases
 	}
 
+	// Alias details - the aliases with the names and URLs DX resolved for them
+	aliasDetails, diags := types.MapValueFrom(ctx, types.ListType{ElemType: aliasDetailsType}, aliasDetailsFromAPI(apiResp.Entity.Aliases))
+	if diags.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Failed to convert alias details: %v", diags))
+	}
+	state.AliasDetails = aliasDetails
+
 	// Computed fields
 	state.CreatedAt = types.StringValue(apiResp.Entity.CreatedAt)
 	state.UpdatedAt = types.StringValue(apiResp.Entity.UpdatedAt)
@@ -12,17 +12,25 @@ type EntityResourceModel struct {
 	Type       types.String `tfsdk:"type"`       // Entity type identifier
 
 	// Optional fields
-	Name         types.String            `tfsdk:"name"`           // Display name
-	Description  types.String            `tfsdk:"description"`    // Entity description
-	OwnerTeamIds []types.String          `tfsdk:"owner_team_ids"` // Array of owner team IDs
-	OwnerUserIds []types.String          `tfsdk:"owner_user_ids"` // Array of owner user IDs
-	Domain       types.String            `tfsdk:"domain"`         // Domain entity identifier
-	Properties   types.Dynamic           `tfsdk:"properties"`     // Entity properties (key-value pairs, values can be strings, numbers, null, objects, or lists)
-	Aliases      map[string][]AliasModel `tfsdk:"aliases"`        // Aliases map (map of alias type to array of alias objects)
+	Name        types.String            `tfsdk:"name"`        // Display name
+	Description types.String            `tfsdk:"description"` // Entity description
+	Domain      types.String            `tfsdk:"domain"`      // Domain entity identifier
+	Properties  types.Dynamic           `tfsdk:"properties"`  // Entity properties (key-value pairs, values can be strings, numbers, null, objects, or lists)
+	Aliases     map[string][]AliasModel `tfsdk:"aliases"`     // Aliases map (map of alias type to array of alias objects)
+
+	// Owners, configured either by ID or by team name and user email. The other form is computed.
+	OwnerTeamIds    types.List `tfsdk:"owner_team_ids"`    // Array of owner team IDs
+	OwnerTeamNames  types.List `tfsdk:"owner_team_names"`  // Array of owner team names
+	OwnerUserIds    types.List `tfsdk:"owner_user_ids"`    // Array of owner user IDs
+	OwnerUserEmails types.List `tfsdk:"owner_user_emails"` // Array of owner user emails
+
+	// Typed alternatives to Properties
+	TypedPropertiesModel
 
 	// Computed fields (from API)
-	CreatedAt types.String `tfsdk:"created_at"` // Creation timestamp
-	UpdatedAt types.String `tfsdk:"updated_at"` // Last update timestamp
+	AliasDetails types.Map    `tfsdk:"alias_details"` // Aliases with their resolved names and URLs (map of alias type to array of AliasDetailsModel)
+	CreatedAt    types.String `tfsdk:"created_at"`    // Creation timestamp
+	UpdatedAt    types.String `tfsdk:"updated_at"`    // Last update timestamp
 }
 
 // AliasModel describes an alias entry for an entity.
@@ -30,3 +38,11 @@ type AliasModel struct {
 	Identifier         types.String `tfsdk:"identifier"`          // Required: the alias identifier
 	InstanceIdentifier types.String `tfsdk:"instance_identifier"` // Optional: the instance identifier
 }
+
+// AliasDetailsModel describes an alias entry with the name and URL DX resolved for it.
+type AliasDetailsModel struct {
+	Identifier         types.String `tfsdk:"identifier"`          // The alias identifier
+	InstanceIdentifier types.String `tfsdk:"instance_identifier"` // The instance identifier, if any
+	Name               types.String `tfsdk:"name"`                // Computed: the name resolved from the Data Cloud database
+	Url                types.String `tfsdk:"url"`                 // Computed: the URL resolved from the Data Cloud database
+}
@@ -0,0 +1,259 @@
+package entity
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ownerKind describes one kind of entity owner. Owners can be configured either by DX ID or by a
+// human readable key, which is resolved to the ID. The other form is computed, so both are in state.
+type ownerKind struct {
+	idAttribute  string
+	keyAttribute string
+	// ids and keys return the model fields holding the two forms.
+	ids  func(*EntityResourceModel) *types.List
+	keys func(*EntityResourceModel) *types.List
+	// sameKey reports whether two keys name the same owner.
+	sameKey func(a, b string) bool
+	// find resolves keys to owner IDs, in the same order.
+	find func(ctx context.Context, client *dxapi.Client, keys []string) ([]string, error)
+}
+
+var (
+	teamOwners = ownerKind{
+		idAttribute:  "owner_team_ids",
+		keyAttribute: "owner_team_names",
+		ids:          func(m *EntityResourceModel) *types.List { return &m.OwnerTeamIds },
+		keys:         func(m *EntityResourceModel) *types.List { return &m.OwnerTeamNames },
+		sameKey:      func(a, b string) bool { return a == b },
+		find: func(ctx context.Context, client *dxapi.Client, names []string) ([]string, error) {
+			teams, err := client.FindTeamsByName(ctx, names)
+			ids := make([]string, 0, len(teams))
+			for _, team := range teams {
+				ids = append(ids, team.Id)
+			}
+			return ids, err
+		},
+	}
+	userOwners = ownerKind{
+		idAttribute:  "owner_user_ids",
+		keyAttribute: "owner_user_emails",
+		ids:          func(m *EntityResourceModel) *types.List { return &m.OwnerUserIds },
+		keys:         func(m *EntityResourceModel) *types.List { return &m.OwnerUserEmails },
+		sameKey:      strings.EqualFold,
+		find: func(ctx context.Context, client *dxapi.Client, emails []string) ([]string, error) {
+			users, err := client.FindUsersByEmail(ctx, emails)
+			ids := make([]string, 0, len(users))
+			for _, user := range users {
+				ids = append(ids, user.Id)
+			}
+			return ids, err
+		},
+	}
+
+	ownerKinds = []ownerKind{teamOwners, userOwners}
+)
+
+// planOwners plans the computed form of each kind of owner from the configured one. Owners
+// configured by key are resolved to IDs at plan time when possible, so unknown team names and
+// emails are reported by `terraform plan`. Otherwise the computed form is unknown until the apply,
+// unless the configured form is unchanged from the prior state.
+func (r *EntityResource) planOwners(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var config, state EntityResourceModel
+	for _, kind := range ownerKinds {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(kind.idAttribute), kind.ids(&config))...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(kind.keyAttribute), kind.keys(&config))...)
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(kind.idAttribute), kind.ids(&state))...)
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(kind.keyAttribute), kind.keys(&state))...)
+		} else {
+			*kind.ids(&state) = types.ListNull(types.StringType)
+			*kind.keys(&state) = types.ListNull(types.StringType)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, kind := range ownerKinds {
+		ids, keys := kind.planned(ctx, r.client, config, state, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(kind.idAttribute), ids)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(kind.keyAttribute), keys)...)
+	}
+}
+
+// planned returns the planned IDs and keys of the owner kind.
+func (kind ownerKind) planned(ctx context.Context, client *dxapi.Client, config, state EntityResourceModel, diags *diag.Diagnostics) (types.List, types.List) {
+	configIds, configKeys := *kind.ids(&config), *kind.keys(&config)
+	stateIds, stateKeys := *kind.ids(&state), *kind.keys(&state)
+	unknown := types.ListUnknown(types.StringType)
+
+	switch {
+	case !configKeys.IsNull():
+		keys, known := listStrings(configKeys)
+		if !known {
+			return unknown, configKeys
+		}
+		if stateKeysMatch(kind, stateKeys, keys) && !stateIds.IsNull() && !stateIds.IsUnknown() {
+			return stateIds, configKeys
+		}
+		if client == nil {
+			return unknown, configKeys
+		}
+		ids, err := kind.find(ctx, client, keys)
+		if err != nil {
+			addOwnerLookupError(diags, kind, err)
+			return unknown, configKeys
+		}
+		return stringList(ids), configKeys
+
+	case !configIds.IsNull():
+		if !configIds.IsUnknown() && configIds.Equal(stateIds) && !stateKeys.IsNull() {
+			return configIds, stateKeys
+		}
+		return configIds, unknown
+
+	default:
+		return types.ListNull(types.StringType), types.ListNull(types.StringType)
+	}
+}
+
+// resolveOwners resolves owners configured by key whose IDs were not known at plan time, e.g.
+// because the keys came from another resource.
+func resolveOwners(ctx context.Context, client *dxapi.Client, plan *EntityResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, kind := range ownerKinds {
+		if !kind.ids(plan).IsUnknown() {
+			continue
+		}
+
+		keys, _ := listStrings(*kind.keys(plan))
+		ids, err := kind.find(ctx, client, keys)
+		if err != nil {
+			addOwnerLookupError(&diags, kind, err)
+			continue
+		}
+		*kind.ids(plan) = stringList(ids)
+	}
+	return diags
+}
+
+func addOwnerLookupError(diags *diag.Diagnostics, kind ownerKind, err error) {
+	var lookupErr *dxapi.LookupError
+	if errors.As(err, &lookupErr) {
+		diags.AddAttributeError(path.Root(kind.keyAttribute), "Unknown entity owner",
+			"Could not resolve the entity owners: "+lookupErr.Error()+". Check the spelling, or use "+kind.idAttribute+" instead.")
+		return
+	}
+	diags.AddAttributeError(path.Root(kind.keyAttribute), "Error resolving entity owners", err.Error())
+}
+
+// ownersFromAPI sets the IDs and keys of the owner kind from the owners the API returned.
+// Owners keep the planned order when the API returns the same owners in a different order, and
+// configured keys keep their spelling, e.g. the case of an email. When the API returned no
+// owners, null values stay null so an unset attribute does not show a diff.
+func ownersFromAPI(kind ownerKind, state, oldPlan *EntityResourceModel, ids, keys []string) {
+	if len(ids) == 0 {
+		*kind.ids(state) = emptyUnlessNull(*kind.ids(oldPlan))
+		*kind.keys(state) = emptyUnlessNull(*kind.keys(oldPlan))
+		return
+	}
+
+	plannedIds, idsKnown := listStrings(*kind.ids(oldPlan))
+	plannedKeys, keysKnown := listStrings(*kind.keys(oldPlan))
+	switch {
+	case idsKnown && len(plannedIds) == len(ids):
+		ids, keys = reorderOwners(ids, keys, plannedIds, func(i, j int) bool { return ids[i] == plannedIds[j] })
+	case keysKnown && len(plannedKeys) == len(keys):
+		ids, keys = reorderOwners(ids, keys, plannedKeys, func(i, j int) bool { return kind.sameKey(keys[i], plannedKeys[j]) })
+	}
+
+	if keysKnown && len(plannedKeys) == len(keys) {
+		for i := range keys {
+			if kind.sameKey(plannedKeys[i], keys[i]) {
+				keys[i] = plannedKeys[i]
+			}
+		}
+	}
+	*kind.ids(state) = stringList(ids)
+	*kind.keys(state) = stringList(keys)
+}
+
+// reorderOwners returns the owners the API returned in the order of planned, where matches
+// reports whether the API owner at i is the planned owner at j. The API order is kept unless
+// every owner matches a planned one.
+func reorderOwners(ids, keys, planned []string, matches func(i, j int) bool) ([]string, []string) {
+	orderedIds := make([]string, len(planned))
+	orderedKeys := make([]string, len(planned))
+	used := make([]bool, len(ids))
+	for j := range planned {
+		found := false
+		for i := range ids {
+			if !used[i] && matches(i, j) {
+				orderedIds[j], orderedKeys[j] = ids[i], keys[i]
+				used[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return ids, keys
+		}
+	}
+	return orderedIds, orderedKeys
+}
+
+func stateKeysMatch(kind ownerKind, stateKeys types.List, keys []string) bool {
+	current, known := listStrings(stateKeys)
+	if !known || stateKeys.IsNull() || len(current) != len(keys) {
+		return false
+	}
+	for i := range keys {
+		if !kind.sameKey(current[i], keys[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// listStrings returns the elements of a list of strings, and whether the list and all of its
+// elements are known. Null elements are skipped.
+func listStrings(list types.List) ([]string, bool) {
+	if list.IsUnknown() {
+		return nil, false
+	}
+	values := make([]string, 0, len(list.Elements()))
+	for _, element := range list.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsUnknown() {
+			return nil, false
+		}
+		if !value.IsNull() {
+			values = append(values, value.ValueString())
+		}
+	}
+	return values, true
+}
+
+func stringList(values []string) types.List {
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func emptyUnlessNull(list types.List) types.List {
+	if list.IsNull() {
+		return list
+	}
+	return stringList(nil)
+}
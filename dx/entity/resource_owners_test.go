@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestOwnersFromAPI verifies that owners read from the API match the planned owners regardless
+// of the order the API returns them in.
+func TestOwnersFromAPI(t *testing.T) {
+	tests := []struct {
+		name              string
+		plannedIds        types.List
+		plannedKeys       types.List
+		apiIds, apiKeys   []string
+		wantIds, wantKeys []string
+	}{
+		{
+			name:        "configured by email in the API order",
+			plannedIds:  stringList([]string{"u1", "u2"}),
+			plannedKeys: stringList([]string{"Ada@example.com", "grace@example.com"}),
+			apiIds:      []string{"u1", "u2"},
+			apiKeys:     []string{"ada@example.com", "grace@example.com"},
+			wantIds:     []string{"u1", "u2"},
+			wantKeys:    []string{"Ada@example.com", "grace@example.com"},
+		},
+		{
+			name:        "configured by email in reverse order",
+			plannedIds:  stringList([]string{"u2", "u1"}),
+			plannedKeys: stringList([]string{"grace@example.com", "Ada@example.com"}),
+			apiIds:      []string{"u1", "u2"},
+			apiKeys:     []string{"ada@example.com", "grace@example.com"},
+			wantIds:     []string{"u2", "u1"},
+			wantKeys:    []string{"grace@example.com", "Ada@example.com"},
+		},
+		{
+			name:        "configured by ID in reverse order",
+			plannedIds:  stringList([]string{"u2", "u1"}),
+			plannedKeys: types.ListUnknown(types.StringType),
+			apiIds:      []string{"u1", "u2"},
+			apiKeys:     []string{"ada@example.com", "grace@example.com"},
+			wantIds:     []string{"u2", "u1"},
+			wantKeys:    []string{"grace@example.com", "ada@example.com"},
+		},
+		{
+			name:        "IDs unknown, emails in reverse order",
+			plannedIds:  types.ListUnknown(types.StringType),
+			plannedKeys: stringList([]string{"GRACE@example.com", "ada@example.com"}),
+			apiIds:      []string{"u1", "u2"},
+			apiKeys:     []string{"ada@example.com", "grace@example.com"},
+			wantIds:     []string{"u2", "u1"},
+			wantKeys:    []string{"GRACE@example.com", "ada@example.com"},
+		},
+		{
+			name:        "owners changed outside of Terraform",
+			plannedIds:  stringList([]string{"u2", "u1"}),
+			plannedKeys: stringList([]string{"grace@example.com", "ada@example.com"}),
+			apiIds:      []string{"u1", "u3"},
+			apiKeys:     []string{"ada@example.com", "alan@example.com"},
+			wantIds:     []string{"u1", "u3"},
+			wantKeys:    []string{"ada@example.com", "alan@example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldPlan := EntityResourceModel{OwnerUserIds: tt.plannedIds, OwnerUserEmails: tt.plannedKeys}
+			var state EntityResourceModel
+
+			ownersFromAPI(userOwners, &state, &oldPlan, tt.apiIds, tt.apiKeys)
+
+			gotIds, _ := listStrings(state.OwnerUserIds)
+			gotKeys, _ := listStrings(state.OwnerUserEmails)
+			if !reflect.DeepEqual(gotIds, tt.wantIds) || !reflect.DeepEqual(gotKeys, tt.wantKeys) {
+				t.Errorf("Expected IDs %v and emails %v, got %v and %v", tt.wantIds, tt.wantKeys, gotIds, gotKeys)
+			}
+		})
+	}
+}
@@ -3,37 +3,19 @@ package entity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func AliasSchema() map[string]schema.Attribute {
-	return map[string]schema.Attribute{
-		"identifier": schema.StringAttribute{
-			Required:    true,
-			Description: "The unique identifier for the alias (e.g., GitHub repository ID).",
-		},
-		"instance_identifier": schema.StringAttribute{
-			Optional:    true,
-			Description: "An optional instance identifier for the alias (e.g. GitHub Enterprise Server instance ID).",
-		},
-		"name": schema.StringAttribute{
-			Computed:    true,
-			Description: "The name of the alias (computed from the Data Cloud database).",
-		},
-		"url": schema.StringAttribute{
-			Computed:    true,
-			Description: "The URL of the alias (computed from the Data Cloud database).",
-		},
-	}
-}
-
 func EntityResourceSchema() map[string]schema.Attribute {
-	return map[string]schema.Attribute{
+	attributes := map[string]schema.Attribute{
 		"id": schema.StringAttribute{
 			Computed:    true,
 			Description: "The unique identifier of the entity (same as 'identifier').",
@@ -63,12 +45,32 @@ func EntityResourceSchema() map[string]schema.Attribute {
 		"owner_team_ids": schema.ListAttribute{
 			ElementType: types.StringType,
 			Optional:    true,
-			Description: "Array of owner team IDs assigned to the entity.",
+			Computed:    true,
+			Description: "Array of owner team IDs assigned to the entity. Computed when the owner teams are configured with 'owner_team_names'.",
+		},
+		"owner_team_names": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Description: "Array of owner team names assigned to the entity, resolved to team IDs by exact name. Conflicts with 'owner_team_ids', and computed when the owner teams are configured with it.",
+			Validators: []validator.List{
+				listvalidator.ConflictsWith(path.MatchRoot("owner_team_ids")),
+			},
 		},
 		"owner_user_ids": schema.ListAttribute{
 			ElementType: types.StringType,
 			Optional:    true,
-			Description: "Array of owner user IDs assigned to the entity.",
+			Computed:    true,
+			Description: "Array of owner user IDs assigned to the entity. Computed when the owner users are configured with 'owner_user_emails'.",
+		},
+		"owner_user_emails": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Description: "Array of owner user emails assigned to the entity, resolved to user IDs ignoring case. Conflicts with 'owner_user_ids', and computed when the owner users are configured with it.",
+			Validators: []validator.List{
+				listvalidator.ConflictsWith(path.MatchRoot("owner_user_ids")),
+			},
 		},
 		"domain": schema.StringAttribute{
 			Optional:    true,
@@ -76,7 +78,7 @@ func EntityResourceSchema() map[string]schema.Attribute {
 		},
 		"properties": schema.DynamicAttribute{
 			Optional:    true,
-			Description: "Key-value pairs of entity properties and their values. Values can be strings, numbers, null, objects, or lists of any of those types. See [EntityProperties](https://docs.getdx.com/webapi/types/properties/) types for valid configuration.",
+			Description: "Key-value pairs of entity properties and their values. Values can be strings, numbers, null, objects, or lists of any of those types. See [EntityProperties](https://docs.getdx.com/webapi/types/properties/) types for valid configuration. Properties are checked against the entity type's property definitions at plan time, using the entity type as it is before the apply.",
 		},
 		"aliases": schema.MapAttribute{
 			ElementType: types.ListType{
@@ -88,7 +90,12 @@ func EntityResourceSchema() map[string]schema.Attribute {
 				},
 			},
 			Optional:    true,
-			Description: "Key-value pairs of aliases assigned to the entity. Keys are alias types (e.g., 'github_repo'), values are arrays of alias objects with 'identifier' (required) and 'instance_identifier' (optional) fields.",
+			Description: "Key-value pairs of aliases assigned to the entity. Keys are alias types (e.g., 'github_repo'), values are arrays of alias objects with 'identifier' (required) and 'instance_identifier' (optional) fields. The names and URLs DX resolves for the aliases are in 'alias_details', since fields of these alias objects cannot be computed.",
+		},
+		"alias_details": schema.MapAttribute{
+			ElementType: types.ListType{ElemType: aliasDetailsType},
+			Computed:    true,
+			Description: "The entity's aliases as stored by DX, keyed by alias type like 'aliases'. Each alias object has the 'identifier' and 'instance_identifier' fields of 'aliases', and the 'name' and 'url' DX resolved for the alias from the Data Cloud database. 'name' and 'url' are null when DX could not resolve the alias.",
 		},
 		"created_at": schema.StringAttribute{
 			Computed:    true,
@@ -102,6 +109,10 @@ func EntityResourceSchema() map[string]schema.Attribute {
 			Description: "Timestamp when the entity was last updated.",
 		},
 	}
+	for name, attribute := range typedPropertiesResourceSchema() {
+		attributes[name] = attribute
+	}
+	return attributes
 }
 
 func (r *EntityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
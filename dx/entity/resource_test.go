@@ -2,10 +2,14 @@ package entity_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 
+	"terraform-provider-dx/dx/dxapi"
 	"terraform-provider-dx/internal/acctest"
 )
 
@@ -163,6 +167,7 @@ resource "dx_entity" "optional" {
 					resource.TestCheckResourceAttr("dx_entity.optional", "type", "service"),
 					resource.TestCheckResourceAttr("dx_entity.optional", "name", entityName),
 					resource.TestCheckResourceAttr("dx_entity.optional", "description", "Entity with some optional fields"),
+					resource.TestCheckResourceAttr("dx_entity.optional", "alias_details.%", "0"),
 					resource.TestCheckResourceAttrSet("dx_entity.optional", "created_at"),
 					resource.TestCheckResourceAttrSet("dx_entity.optional", "updated_at"),
 				),
@@ -195,8 +200,46 @@ resource "dx_entity" "optional" {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dx_entity.optional", "identifier", entityIdentifier),
 					resource.TestCheckResourceAttr("dx_entity.optional", "type", "service"),
+					resource.TestCheckResourceAttr("dx_entity.optional", "alias_details.github_repo.0.identifier", "962275774"),
+					resource.TestCheckResourceAttrSet("dx_entity.optional", "alias_details.github_repo.0.name"),
+					resource.TestCheckResourceAttrSet("dx_entity.optional", "alias_details.github_repo.0.url"),
 				),
 			},
+			// Changing another attribute keeps the alias details known in the plan
+			{
+				Config: fmt.Sprintf(`
+provider "dx" {}
+
+resource "dx_entity" "optional" {
+  identifier  = "%s"
+  type        = "service"
+  name        = "%s"
+  description = "Entity with an updated description"
+
+  properties = {
+    tier = "Tier-3"
+  }
+
+  aliases = {
+    github_repo = [
+      {
+        identifier          = "962275774"
+        instance_identifier = null
+      }
+    ]
+  }
+}
+
+output "repo_url" {
+  value = dx_entity.optional.alias_details["github_repo"][0].url
+}
+`, entityIdentifier, entityName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownOutputValue("repo_url", knownvalue.NotNull()),
+					},
+				},
+			},
 			// Update to remove aliases (back to null)
 			{
 				Config: fmt.Sprintf(`
@@ -216,6 +259,277 @@ resource "dx_entity" "optional" {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dx_entity.optional", "identifier", entityIdentifier),
 					resource.TestCheckResourceAttr("dx_entity.optional", "type", "service"),
+					resource.TestCheckResourceAttr("dx_entity.optional", "alias_details.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDxEntityResourceDeletedOutOfBand(t *testing.T) {
+	entityIdentifier := fmt.Sprintf("tf_disappears_entity_%d", acctest.RandInt())
+
+	var testAccDxEntityResourceDisappears = fmt.Sprintf(`
+provider "dx" {}
+
+resource "dx_entity" "disappears" {
+  identifier = "%s"
+  type       = "service"
+}
+`, entityIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Deleting the entity outside of Terraform should plan a re-create, not fail the refresh
+			{
+				Config:             testAccDxEntityResourceDisappears,
+				Check:              acctest.CheckDeletedOutOfBand("dx_entity.disappears", "identifier", (*dxapi.Client).DeleteEntity),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDxEntityResourceInvalidProperties(t *testing.T) {
+	entityTypeIdentifier := fmt.Sprintf("tf_invalid_props_type_%d", acctest.RandInt())
+	entityIdentifier := fmt.Sprintf("tf_invalid_props_entity_%d", acctest.RandInt())
+
+	config := func(extraOption, extraProperty, entity string) string {
+		return fmt.Sprintf(`
+provider "dx" {}
+
+resource "dx_entity_type" "invalid" {
+  identifier = "%s"
+  name       = "Invalid Properties Test Type"
+
+  properties = {
+    runbook = { name = "Runbook", type = "url" }
+    language = {
+      name    = "Language"
+      type    = "multi_select"
+      options = [{ value = "Go", color = "#00ADD8" }, { value = "Python", color = "#3776AB" }%s]
+    }
+    %s
+  }
+}
+
+%s
+`, entityTypeIdentifier, extraOption, extraProperty, entity)
+	}
+	entity := func(properties string) string {
+		return fmt.Sprintf(`
+resource "dx_entity" "invalid" {
+  identifier = "%s"
+  type       = dx_entity_type.invalid.identifier
+
+  properties = {
+    %s
+  }
+}
+`, entityIdentifier, properties)
+	}
+	rust := `, { value = "Rust", color = "#DEA584" }`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("", "", ""),
+			},
+			// Values that do not match the entity type only warn at plan time, and DX rejects them
+			{
+				Config:      config("", "", entity(`language = ["Go", "Rust"]`)),
+				ExpectError: regexp.MustCompile(`Rust`),
+			},
+			// An option added to the entity type can be used by an entity in the same apply
+			{
+				Config: config(rust, "", entity(`language = ["Go", "Rust"]`)),
+				Check:  resource.TestCheckResourceAttr("dx_entity.invalid", "properties.language.1", "Rust"),
+			},
+			// So can a property added to the entity type
+			{
+				Config: config(rust, `tier = { name = "Tier", type = "text" }`, entity(`tier = "Tier-1"`)),
+				Check:  resource.TestCheckResourceAttr("dx_entity.invalid", "properties.tier", "Tier-1"),
+			},
+		},
+	})
+}
+
+func TestAccDxEntityResourceTypedProperties(t *testing.T) {
+	entityTypeIdentifier := fmt.Sprintf("tf_typed_props_type_%d", acctest.RandInt())
+	entityIdentifier := fmt.Sprintf("tf_typed_props_entity_%d", acctest.RandInt())
+
+	config := func(entityBody string) string {
+		return fmt.Sprintf(`
+provider "dx" {}
+
+resource "dx_entity_type" "typed" {
+  identifier = "%s"
+  name       = "Typed Properties Test Type"
+
+  properties = {
+    owner    = { name = "Owner", type = "text" }
+    replicas = { name = "Replicas", type = "number" }
+    cpu      = { name = "CPU", type = "number" }
+    public   = { name = "Public", type = "boolean" }
+    tags     = { name = "Tags", type = "list" }
+    launched = { name = "Launched", type = "date" }
+  }
+}
+
+resource "dx_entity" "typed" {
+  identifier = "%s"
+  type       = dx_entity_type.typed.identifier
+  %s
+}
+
+data "dx_entity" "typed" {
+  identifier = dx_entity.typed.identifier
+}
+
+data "dx_entities" "typed" {
+  type       = dx_entity_type.typed.identifier
+  depends_on = [dx_entity.typed]
+}
+`, entityTypeIdentifier, entityIdentifier, entityBody)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A property can only be set in one place
+			{
+				Config: config(`
+  properties      = { owner = "platform" }
+  text_properties = { owner = "data" }
+`),
+				ExpectError: regexp.MustCompile("Property set more than once"),
+			},
+			// Set properties through the typed maps
+			{
+				Config: config(`
+  text_properties    = { owner = "platform" }
+  number_properties  = { replicas = 3, cpu = 0.1 }
+  boolean_properties = { public = true }
+  list_properties    = { tags = ["payments", "tier-1"] }
+  date_properties    = { launched = "2024-01-15" }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dx_entity.typed", "text_properties.owner", "platform"),
+					resource.TestCheckResourceAttr("dx_entity.typed", "number_properties.replicas", "3"),
+					resource.TestCheckResourceAttr("dx_entity.typed", "number_properties.cpu", "0.1"),
+					resource.TestCheckResourceAttr("dx_entity.typed", "boolean_properties.public", "true"),
+					resource.TestCheckResourceAttr("dx_entity.typed", "list_properties.tags.#", "2"),
+					resource.TestCheckResourceAttr("dx_entity.typed", "date_properties.launched", "2024-01-15"),
+					resource.TestCheckNoResourceAttr("dx_entity.typed", "properties"),
+
+					resource.TestCheckResourceAttr("data.dx_entity.typed", "text_properties.owner", "platform"),
+					resource.TestCheckResourceAttr("data.dx_entity.typed", "number_properties.replicas", "3"),
+					resource.TestCheckResourceAttr("data.dx_entity.typed", "boolean_properties.public", "true"),
+					resource.TestCheckResourceAttr("data.dx_entity.typed", "list_properties.tags.1", "tier-1"),
+					resource.TestCheckResourceAttr("data.dx_entity.typed", "date_properties.launched", "2024-01-15"),
+					resource.TestCheckResourceAttr("data.dx_entity.typed", "text_properties.%", "1"),
+
+					resource.TestCheckResourceAttr("data.dx_entities.typed", "entities.0.number_properties.replicas", "3"),
+					resource.TestCheckResourceAttr("data.dx_entities.typed", "entities.0.date_properties.launched", "2024-01-15"),
+				),
+			},
+			// Move a property to the untyped map and remove another
+			{
+				Config: config(`
+  properties         = { replicas = 5 }
+  text_properties    = { owner = "platform" }
+  list_properties    = { tags = ["payments"] }
+  date_properties    = { launched = "2024-01-15" }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dx_entity.typed", "properties.replicas", "5"),
+					resource.TestCheckNoResourceAttr("dx_entity.typed", "number_properties"),
+					resource.TestCheckNoResourceAttr("dx_entity.typed", "boolean_properties"),
+					resource.TestCheckResourceAttr("dx_entity.typed", "list_properties.tags.#", "1"),
+					resource.TestCheckResourceAttr("data.dx_entity.typed", "boolean_properties.%", "0"),
+					resource.TestCheckResourceAttr("data.dx_entity.typed", "number_properties.replicas", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDxEntityResourceOwnersByNameAndEmail(t *testing.T) {
+	// Teams and users cannot be created through the API, so this uses the fake's seeded ones
+	acctest.SkipWithRealAPI(t)
+
+	entityIdentifier := fmt.Sprintf("tf_owners_entity_%d", acctest.RandInt())
+
+	config := func(teamNames, userEmails string) string {
+		return fmt.Sprintf(`
+provider "dx" {}
+
+resource "dx_entity" "by_name" {
+  identifier        = "%[1]s"
+  type              = "service"
+  owner_team_names  = %[2]s
+  owner_user_emails = %[3]s
+}
+
+resource "dx_entity" "by_id" {
+  identifier     = "%[1]s_by_id"
+  type           = "service"
+  owner_team_ids = dx_entity.by_name.owner_team_ids
+  owner_user_ids = dx_entity.by_name.owner_user_ids
+}
+`, entityIdentifier, teamNames, userEmails)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The ID and name forms are mutually exclusive
+			{
+				Config: fmt.Sprintf(`
+provider "dx" {}
+
+resource "dx_entity" "by_name" {
+  identifier       = "%s"
+  type             = "service"
+  owner_team_names = ["Payments"]
+  owner_team_ids   = ["fk000001"]
+}
+`, entityIdentifier),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Unknown names are reported at plan time
+			{
+				Config:      config(`["No Such Team"]`, `["ada@example.com"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`no team matches "No Such Team"`),
+			},
+			{
+				Config: config(`["Payments"]`, `["ADA@example.com"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dx_entity.by_name", "owner_team_names.0", "Payments"),
+					resource.TestCheckResourceAttr("dx_entity.by_name", "owner_team_ids.#", "1"),
+					resource.TestCheckResourceAttr("dx_entity.by_name", "owner_user_emails.0", "ADA@example.com"),
+					resource.TestCheckResourceAttr("dx_entity.by_name", "owner_user_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("dx_entity.by_id", "owner_team_ids.0", "dx_entity.by_name", "owner_team_ids.0"),
+					resource.TestCheckResourceAttr("dx_entity.by_id", "owner_team_names.0", "Payments"),
+					resource.TestCheckResourceAttr("dx_entity.by_id", "owner_user_emails.0", "ada@example.com"),
+				),
+			},
+			{
+				Config: config(`["Developer Experience", "Payments"]`, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dx_entity.by_name", "owner_team_ids.#", "2"),
+					resource.TestCheckResourceAttr("dx_entity.by_id", "owner_team_names.0", "Developer Experience"),
+					resource.TestCheckResourceAttr("dx_entity.by_id", "owner_team_names.1", "Payments"),
+					resource.TestCheckResourceAttr("dx_entity.by_name", "owner_user_ids.#", "0"),
+					resource.TestCheckResourceAttr("dx_entity.by_id", "owner_user_emails.#", "0"),
 				),
 			},
 		},
@@ -0,0 +1,306 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithModifyPlan     = &EntityResource{}
+	_ resource.ResourceWithValidateConfig = &EntityResource{}
+)
+
+// ValidateConfig checks that no property is set both in `properties` and a typed property map, or
+// in two typed property maps.
+func (r *EntityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var properties types.Dynamic
+	var typed TypedPropertiesModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("properties"), &properties)...)
+	for _, kind := range propertyKinds {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(kind.attribute), kind.get(&typed))...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTypedPropertyKeys(properties, typed)...)
+}
+
+// ModifyPlan plans the owners and alias details, and checks the planned properties against the property
+// definitions of the entity type, so typos and invalid values are pointed out by `terraform plan`.
+// The entity type is read as it is before the apply, which may change it, so problems with the
+// properties are warnings and the API has the final say.
+func (r *EntityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when destroying
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.planOwners(ctx, req, resp)
+	resp.Diagnostics.Append(planAliasDetails(ctx, req, resp)...)
+
+	// Nothing to check before the provider is configured
+	if resp.Diagnostics.HasError() || r.client == nil {
+		return
+	}
+
+	var entityType types.String
+	var properties types.Dynamic
+	var typed TypedPropertiesModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &entityType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("properties"), &properties)...)
+	for _, kind := range propertyKinds {
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(kind.attribute), kind.get(&typed))...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hasProperties := !properties.IsNull() && !properties.IsUnknown()
+	if entityType.IsUnknown() || (!hasProperties && len(typedPropertiesToGoValues(typed)) == 0) {
+		return
+	}
+
+	apiEntityType, err := r.client.CachedEntityType(ctx, entityType.ValueString())
+	if err != nil {
+		// The entity type may be created in the same apply, in which case the API validates the
+		// properties when the entity is created
+		if dxapi.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading entity type", fmt.Sprintf("Could not read entity type %s to validate the entity's properties: %s", entityType.ValueString(), err.Error()))
+		return
+	}
+
+	if hasProperties {
+		resp.Diagnostics.Append(validateProperties(apiEntityType, properties)...)
+	}
+	resp.Diagnostics.Append(validateTypedProperties(apiEntityType, typed)...)
+}
+
+// validateProperties warns about properties that the entity type does not define, values whose
+// shape does not match the property type, invalid select options and values for computed
+// properties. Null and unknown values are not checked.
+func validateProperties(entityType *dxapi.APIEntityType, properties types.Dynamic) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	values, ok := objectElements(properties.UnderlyingValue())
+	if !ok {
+		diags.AddAttributeError(path.Root("properties"), "Invalid entity properties", "Properties must be an object of property identifiers to values.")
+		return diags
+	}
+
+	return validatePropertyValues(entityType, path.Root("properties"), values)
+}
+
+// validateTypedProperties validates the typed property maps like validateProperties, and also
+// reports `date_properties` that are not date properties.
+func validateTypedProperties(entityType *dxapi.APIEntityType, typed TypedPropertiesModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, kind := range propertyKinds {
+		typedMap := *kind.get(&typed)
+		if typedMap.IsNull() || typedMap.IsUnknown() {
+			continue
+		}
+		diags.Append(validatePropertyValues(entityType, path.Root(kind.attribute), typedMap.Elements())...)
+	}
+
+	if !typed.DateProperties.IsNull() && !typed.DateProperties.IsUnknown() {
+		for _, prop := range entityType.Properties {
+			if prop == nil {
+				continue
+			}
+			if _, ok := typed.DateProperties.Elements()[prop.Identifier]; ok && prop.Type != "date" {
+				addPropertyWarning(&diags, path.Root(dateKind.attribute).AtMapKey(prop.Identifier),
+					"Invalid property value",
+					fmt.Sprintf("Property %q is a %s property, so it cannot be set in date_properties.", prop.Identifier, prop.Type),
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+// validatePropertyValues validates property values keyed by property identifier, reporting
+// warnings against the matching key of the attribute at root.
+func validatePropertyValues(entityType *dxapi.APIEntityType, root path.Path, values map[string]attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	definitions := make(map[string]*dxapi.APIProperty, len(entityType.Properties))
+	for _, prop := range entityType.Properties {
+		if prop != nil {
+			definitions[prop.Identifier] = prop
+		}
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := unwrapDynamic(values[key])
+		if value == nil || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		attrPath := root.AtMapKey(key)
+
+		prop, ok := definitions[key]
+		if !ok {
+			addPropertyWarning(&diags, attrPath,
+				"Unknown entity property",
+				fmt.Sprintf("Entity type %q has no property %q. Valid properties are: %s.", entityType.Identifier, key, strings.Join(propertyIdentifiers(entityType), ", ")),
+			)
+			continue
+		}
+		if summary, detail := validatePropertyValue(prop, value); summary != "" {
+			addPropertyWarning(&diags, attrPath, summary, detail)
+		}
+	}
+
+	return diags
+}
+
+// addPropertyWarning reports a property that does not match the entity type as it is before the
+// apply. It is only a warning, as the entity type may be changed in the same apply.
+func addPropertyWarning(diags *diag.Diagnostics, attrPath path.Path, summary, detail string) {
+	diags.AddAttributeWarning(attrPath, summary, detail+" Ignore this warning if the entity type is changed to match in this apply, otherwise DX will reject the value.")
+}
+
+// validatePropertyValue checks a single property value against its definition, returning the
+// summary and detail of the problem or empty strings if the value is valid.
+func validatePropertyValue(prop *dxapi.APIProperty, value attr.Value) (string, string) {
+	switch prop.Type {
+	case "computed":
+		return "Computed property cannot be set", fmt.Sprintf("Property %q is computed by DX and cannot be set on an entity.", prop.Identifier)
+	case "json":
+		return "", ""
+	case "boolean":
+		if _, ok := value.(types.Bool); !ok {
+			return "Invalid property value", fmt.Sprintf("Property %q is a boolean property, so its value must be true or false.", prop.Identifier)
+		}
+	case "number":
+		switch value.(type) {
+		case types.Number, types.Int64, types.Float64:
+		default:
+			return "Invalid property value", fmt.Sprintf("Property %q is a number property, so its value must be a number.", prop.Identifier)
+		}
+	case "list", "multi_select":
+		items, ok := listElements(value)
+		if !ok {
+			return "Invalid property value", fmt.Sprintf("Property %q is a %s property, so its value must be a list of strings.", prop.Identifier, prop.Type)
+		}
+		for _, item := range items {
+			item = unwrapDynamic(item)
+			if item == nil || item.IsNull() || item.IsUnknown() {
+				continue
+			}
+			s, ok := item.(types.String)
+			if !ok {
+				return "Invalid property value", fmt.Sprintf("Property %q is a %s property, so its value must be a list of strings.", prop.Identifier, prop.Type)
+			}
+			if prop.Type == "multi_select" && !hasOption(prop, s.ValueString()) {
+				return invalidOption(prop, s.ValueString())
+			}
+		}
+	default:
+		s, ok := value.(types.String)
+		if !ok {
+			return "Invalid property value", fmt.Sprintf("Property %q is a %s property, so its value must be a string.", prop.Identifier, prop.Type)
+		}
+		switch prop.Type {
+		case "select":
+			if !hasOption(prop, s.ValueString()) {
+				return invalidOption(prop, s.ValueString())
+			}
+		case "url":
+			if u, err := url.Parse(s.ValueString()); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return "Invalid property value", fmt.Sprintf("Property %q is a url property, so its value must be an http or https URL, got %q.", prop.Identifier, s.ValueString())
+			}
+		}
+	}
+	return "", ""
+}
+
+func invalidOption(prop *dxapi.APIProperty, value string) (string, string) {
+	options := []string{}
+	if prop.Definition != nil {
+		for _, option := range prop.Definition.Options {
+			options = append(options, fmt.Sprintf("%q", option.Value))
+		}
+	}
+	return "Invalid property option", fmt.Sprintf("%q is not an option of property %q. Valid options are: %s.", value, prop.Identifier, strings.Join(options, ", "))
+}
+
+func hasOption(prop *dxapi.APIProperty, value string) bool {
+	if prop.Definition == nil {
+		return false
+	}
+	for _, option := range prop.Definition.Options {
+		if option.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+func propertyIdentifiers(entityType *dxapi.APIEntityType) []string {
+	identifiers := make([]string, 0, len(entityType.Properties))
+	for _, prop := range entityType.Properties {
+		if prop != nil {
+			identifiers = append(identifiers, prop.Identifier)
+		}
+	}
+	sort.Strings(identifiers)
+	return identifiers
+}
+
+// objectElements returns the attributes of an object or map value, as written in configuration.
+func objectElements(value attr.Value) (map[string]attr.Value, bool) {
+	switch v := unwrapDynamic(value).(type) {
+	case types.Object:
+		return v.Attributes(), true
+	case types.Map:
+		return v.Elements(), true
+	default:
+		return nil, false
+	}
+}
+
+// listElements returns the elements of a list, tuple or set value.
+func listElements(value attr.Value) ([]attr.Value, bool) {
+	switch v := value.(type) {
+	case types.Tuple:
+		return v.Elements(), true
+	case types.List:
+		return v.Elements(), true
+	case types.Set:
+		return v.Elements(), true
+	default:
+		return nil, false
+	}
+}
+
+// unwrapDynamic returns the value inside a dynamic value, or the value itself.
+func unwrapDynamic(value attr.Value) attr.Value {
+	for {
+		dynamic, ok := value.(types.Dynamic)
+		if !ok || dynamic.IsNull() || dynamic.IsUnknown() {
+			return value
+		}
+		value = dynamic.UnderlyingValue()
+	}
+}
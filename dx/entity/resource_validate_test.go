@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestValidateProperties verifies that planned properties are checked against the property
+// definitions of the entity type, as warnings.
+func TestValidateProperties(t *testing.T) {
+	options := &dxapi.APIPropertyDefinition{Options: []dxapi.APIPropertyOption{{Value: "Go"}, {Value: "Python"}}}
+	entityType := &dxapi.APIEntityType{
+		Identifier: "service",
+		Properties: []*dxapi.APIProperty{
+			{Identifier: "tier", Type: "text"},
+			{Identifier: "runbook", Type: "url"},
+			{Identifier: "language", Type: "multi_select", Definition: options},
+			{Identifier: "primary-language", Type: "select", Definition: options},
+			{Identifier: "replicas", Type: "number"},
+			{Identifier: "public", Type: "boolean"},
+			{Identifier: "score", Type: "computed"},
+		},
+	}
+	strs := func(values ...string) attr.Value {
+		elemTypes := make([]attr.Type, 0, len(values))
+		elems := make([]attr.Value, 0, len(values))
+		for _, value := range values {
+			elemTypes = append(elemTypes, types.StringType)
+			elems = append(elems, types.StringValue(value))
+		}
+		return types.TupleValueMust(elemTypes, elems)
+	}
+
+	tests := []struct {
+		name        string
+		value       attr.Value
+		key         string
+		wantWarning string
+	}{
+		{name: "text", key: "tier", value: types.StringValue("Tier-1")},
+		{name: "url", key: "runbook", value: types.StringValue("https://example.com/runbook")},
+		{name: "multi_select", key: "language", value: strs("Go", "Python")},
+		{name: "select", key: "primary-language", value: types.StringValue("Go")},
+		{name: "number", key: "replicas", value: types.NumberValue(big.NewFloat(3))},
+		{name: "boolean", key: "public", value: types.BoolValue(true)},
+		{name: "unknown value", key: "tier", value: types.StringUnknown()},
+		{name: "unknown property", key: "teir", value: types.StringValue("Tier-1"), wantWarning: `has no property "teir"`},
+		{name: "text given a list", key: "tier", value: strs("Tier-1"), wantWarning: "must be a string"},
+		{name: "url without a scheme", key: "runbook", value: types.StringValue("example.com/runbook"), wantWarning: "must be an http or https URL"},
+		{name: "multi_select option", key: "language", value: strs("Go", "Rust"), wantWarning: `"Rust" is not an option`},
+		{name: "multi_select given a string", key: "language", value: types.StringValue("Go"), wantWarning: "must be a list of strings"},
+		{name: "select option", key: "primary-language", value: types.StringValue("Rust"), wantWarning: `"Rust" is not an option`},
+		{name: "number given a string", key: "replicas", value: types.StringValue("3"), wantWarning: "must be a number"},
+		{name: "boolean given a string", key: "public", value: types.StringValue("true"), wantWarning: "must be true or false"},
+		{name: "computed", key: "score", value: types.StringValue("10"), wantWarning: "cannot be set"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			properties := types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{tt.key: tt.value.Type(nil)},
+				map[string]attr.Value{tt.key: tt.value},
+			))
+
+			diags := validateProperties(entityType, properties)
+
+			if diags.HasError() {
+				t.Fatalf("Expected only warnings, got: %v", diags)
+			}
+			if tt.wantWarning == "" {
+				if diags.WarningsCount() != 0 {
+					t.Errorf("Expected no warnings, got: %v", diags)
+				}
+				return
+			}
+			if diags.WarningsCount() != 1 || !strings.Contains(diags.Warnings()[0].Detail(), tt.wantWarning) {
+				t.Errorf("Expected one warning containing %q, got: %v", tt.wantWarning, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package entity
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TypedPropertiesModel holds entity properties in maps typed by value, an alternative to the
+// untyped `properties` attribute that keeps type information in plans and outputs.
+type TypedPropertiesModel struct {
+	TextProperties    types.Map `tfsdk:"text_properties"`    // Text, url, select and user properties
+	NumberProperties  types.Map `tfsdk:"number_properties"`  // Number properties
+	BooleanProperties types.Map `tfsdk:"boolean_properties"` // Boolean properties
+	ListProperties    types.Map `tfsdk:"list_properties"`    // List and multi_select properties
+	DateProperties    types.Map `tfsdk:"date_properties"`    // Date properties
+}
+
+// propertyKind identifies one of the typed property maps.
+type propertyKind struct {
+	attribute   string
+	elementType attr.Type
+	description string
+}
+
+var (
+	textKind    = propertyKind{"text_properties", types.StringType, "Text, `url`, `select` and `user` properties, keyed by property identifier."}
+	numberKind  = propertyKind{"number_properties", types.NumberType, "Number properties, keyed by property identifier."}
+	booleanKind = propertyKind{"boolean_properties", types.BoolType, "Boolean properties, keyed by property identifier."}
+	listKind    = propertyKind{"list_properties", types.ListType{ElemType: types.StringType}, "List and `multi_select` properties, keyed by property identifier."}
+	dateKind    = propertyKind{"date_properties", types.StringType, "Date properties, keyed by property identifier."}
+
+	propertyKinds = []propertyKind{textKind, numberKind, booleanKind, listKind, dateKind}
+)
+
+// get returns the map of the kind in m.
+func (k propertyKind) get(m *TypedPropertiesModel) *types.Map {
+	switch k.attribute {
+	case numberKind.attribute:
+		return &m.NumberProperties
+	case booleanKind.attribute:
+		return &m.BooleanProperties
+	case listKind.attribute:
+		return &m.ListProperties
+	case dateKind.attribute:
+		return &m.DateProperties
+	default:
+		return &m.TextProperties
+	}
+}
+
+func typedPropertiesResourceSchema() map[string]resourceschema.Attribute {
+	attributes := make(map[string]resourceschema.Attribute, len(propertyKinds))
+	for _, kind := range propertyKinds {
+		attributes[kind.attribute] = resourceschema.MapAttribute{
+			ElementType: kind.elementType,
+			Optional:    true,
+			Description: kind.description + " A property can only be set in one of `properties` and the typed property maps.",
+		}
+	}
+	return attributes
+}
+
+func typedPropertiesDataSourceSchema() map[string]datasourceschema.Attribute {
+	attributes := make(map[string]datasourceschema.Attribute, len(propertyKinds))
+	for _, kind := range propertyKinds {
+		attributes[kind.attribute] = datasourceschema.MapAttribute{
+			ElementType: kind.elementType,
+			Computed:    true,
+			Description: kind.description + " Computed properties are included by the type of their value. The same values are also in `properties`.",
+		}
+	}
+	return attributes
+}
+
+// kindOfProperty returns the typed map a property value belongs in, based on the property's
+// definition or, for computed and undefined properties, the shape of the value. ok is false for
+// values that fit none of them, such as json properties.
+func kindOfProperty(prop *dxapi.APIProperty, value interface{}) (kind propertyKind, ok bool) {
+	if prop != nil {
+		switch prop.Type {
+		case "text", "url", "select", "user", "openapi":
+			return textKind, true
+		case "date":
+			return dateKind, true
+		case "number":
+			return numberKind, true
+		case "boolean":
+			return booleanKind, true
+		case "list", "multi_select":
+			return listKind, true
+		case "json":
+			return propertyKind{}, false
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return textKind, true
+	case float64:
+		return numberKind, true
+	case bool:
+		return booleanKind, true
+	case []interface{}:
+		for _, item := range v {
+			if _, isString := item.(string); !isString {
+				return propertyKind{}, false
+			}
+		}
+		return listKind, true
+	}
+	return propertyKind{}, false
+}
+
+// typedPropertyValue converts a property value from the API to an element of the kind's map,
+// or returns ok false if the value does not have the kind's shape.
+func typedPropertyValue(kind propertyKind, value interface{}) (attr.Value, bool) {
+	switch kind.attribute {
+	case numberKind.attribute:
+		if f, ok := value.(float64); ok {
+			return types.NumberValue(big.NewFloat(f)), true
+		}
+	case booleanKind.attribute:
+		if b, ok := value.(bool); ok {
+			return types.BoolValue(b), true
+		}
+	case listKind.attribute:
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, false
+		}
+		elements := make([]attr.Value, 0, len(items))
+		for _, item := range items {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			elements = append(elements, types.StringValue(s))
+		}
+		return types.ListValueMust(types.StringType, elements), true
+	default:
+		if s, ok := value.(string); ok {
+			return types.StringValue(s), true
+		}
+	}
+	return nil, false
+}
+
+// typedPropertiesFromAPI sorts every property from the API into the typed maps, using the
+// property definitions of entityType when it is not nil. Every map is set, if only to an empty map.
+func typedPropertiesFromAPI(entityType *dxapi.APIEntityType, properties map[string]interface{}) TypedPropertiesModel {
+	definitions := map[string]*dxapi.APIProperty{}
+	if entityType != nil {
+		for _, prop := range entityType.Properties {
+			if prop != nil {
+				definitions[prop.Identifier] = prop
+			}
+		}
+	}
+
+	elements := map[string]map[string]attr.Value{}
+	for _, kind := range propertyKinds {
+		elements[kind.attribute] = map[string]attr.Value{}
+	}
+	for key, value := range properties {
+		if value == nil {
+			continue
+		}
+		kind, ok := kindOfProperty(definitions[key], value)
+		if !ok {
+			continue
+		}
+		if element, ok := typedPropertyValue(kind, value); ok {
+			elements[kind.attribute][key] = element
+		}
+	}
+
+	var model TypedPropertiesModel
+	for _, kind := range propertyKinds {
+		*kind.get(&model) = types.MapValueMust(kind.elementType, elements[kind.attribute])
+	}
+	return model
+}
+
+// splitTypedProperties moves the API properties that old set in a typed map back into that map
+// and returns the rest, which belong in `properties`. Typed maps that were null in old stay null
+// so that configurations without them show no diff.
+func splitTypedProperties(old TypedPropertiesModel, properties map[string]interface{}) (TypedPropertiesModel, map[string]interface{}) {
+	rest := make(map[string]interface{}, len(properties))
+	for key, value := range properties {
+		rest[key] = value
+	}
+
+	var model TypedPropertiesModel
+	for _, kind := range propertyKinds {
+		oldMap := *kind.get(&old)
+		if oldMap.IsNull() || oldMap.IsUnknown() {
+			*kind.get(&model) = types.MapNull(kind.elementType)
+			continue
+		}
+
+		elements := map[string]attr.Value{}
+		for key, oldValue := range oldMap.Elements() {
+			value, ok := rest[key]
+			if !ok || value == nil {
+				continue
+			}
+			element, ok := typedPropertyValue(kind, value)
+			if !ok {
+				// The API no longer returns this shape, so leave the value in `properties` where the drift shows
+				continue
+			}
+			// Keep the configured number when it only differs from the API's by float precision
+			if oldNumber, isNumber := oldValue.(types.Number); isNumber && !oldNumber.IsNull() && !oldNumber.IsUnknown() {
+				if f, _ := oldNumber.ValueBigFloat().Float64(); f == value {
+					element = oldNumber
+				}
+			}
+			elements[key] = element
+			delete(rest, key)
+		}
+		*kind.get(&model) = types.MapValueMust(kind.elementType, elements)
+	}
+	return model, rest
+}
+
+// typedPropertiesToGoValues returns the properties set in the typed maps in the form sent to the
+// API. Null and unknown maps and elements are skipped.
+func typedPropertiesToGoValues(model TypedPropertiesModel) map[string]interface{} {
+	values := map[string]interface{}{}
+	for _, kind := range propertyKinds {
+		typedMap := *kind.get(&model)
+		if typedMap.IsNull() || typedMap.IsUnknown() {
+			continue
+		}
+		for key, element := range typedMap.Elements() {
+			if element.IsNull() || element.IsUnknown() {
+				continue
+			}
+			if goValue, err := attrValueToGoValue(element); err == nil {
+				values[key] = goValue
+			}
+		}
+	}
+	return values
+}
+
+// validateTypedPropertyKeys reports properties that are set in more than one of `properties` and
+// the typed maps, since the API cannot hold two values for one property.
+func validateTypedPropertyKeys(properties types.Dynamic, model TypedPropertiesModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	owners := map[string]string{}
+	if !properties.IsNull() && !properties.IsUnknown() {
+		if values, ok := objectElements(properties.UnderlyingValue()); ok {
+			for key := range values {
+				owners[key] = "properties"
+			}
+		}
+	}
+
+	for _, kind := range propertyKinds {
+		typedMap := *kind.get(&model)
+		if typedMap.IsNull() || typedMap.IsUnknown() {
+			continue
+		}
+		keys := make([]string, 0, len(typedMap.Elements()))
+		for key := range typedMap.Elements() {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if owner, ok := owners[key]; ok {
+				diags.AddAttributeError(
+					path.Root(kind.attribute).AtMapKey(key),
+					"Property set more than once",
+					fmt.Sprintf("Property %q is set in both %s and %s. Set each property in only one of them.", key, owner, kind.attribute),
+				)
+				continue
+			}
+			owners[key] = kind.attribute
+		}
+	}
+
+	return diags
+}
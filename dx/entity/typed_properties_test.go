@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"math/big"
+	"testing"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTypedPropertiesFromAPI(t *testing.T) {
+	entityType := &dxapi.APIEntityType{
+		Properties: []*dxapi.APIProperty{
+			{Identifier: "launched", Type: "date"},
+			{Identifier: "runbook", Type: "url"},
+			{Identifier: "config", Type: "json"},
+			{Identifier: "score", Type: "computed"},
+		},
+	}
+	properties := map[string]interface{}{
+		"launched":   "2024-01-15",
+		"runbook":    "https://example.com",
+		"config":     map[string]interface{}{"replicas": 3.0},
+		"score":      42.0,
+		"undeclared": []interface{}{"a", "b"},
+	}
+
+	typed := typedPropertiesFromAPI(entityType, properties)
+
+	if got := typed.DateProperties.Elements()["launched"]; !got.Equal(types.StringValue("2024-01-15")) {
+		t.Errorf("Expected the date property in date_properties, got %v", typed.DateProperties)
+	}
+	if got := typed.TextProperties.Elements(); len(got) != 1 || !got["runbook"].Equal(types.StringValue("https://example.com")) {
+		t.Errorf("Expected only the url property in text_properties, got %v", typed.TextProperties)
+	}
+	if got := typed.NumberProperties.Elements()["score"]; !got.Equal(types.NumberValue(big.NewFloat(42))) {
+		t.Errorf("Expected the computed property to be sorted by its value, got %v", typed.NumberProperties)
+	}
+	if _, ok := typed.ListProperties.Elements()["undeclared"]; !ok {
+		t.Errorf("Expected an undeclared list of strings in list_properties, got %v", typed.ListProperties)
+	}
+	if typed.BooleanProperties.IsNull() || len(typed.BooleanProperties.Elements()) != 0 {
+		t.Errorf("Expected an empty boolean_properties map, got %v", typed.BooleanProperties)
+	}
+}
+
+// TestSplitTypedProperties verifies that properties are read back into the typed map they were
+// configured in, and that configured numbers survive float64 round trips through the API.
+func TestSplitTypedProperties(t *testing.T) {
+	configuredCPU, _, err := big.ParseFloat("0.1", 10, 512, big.ToNearestEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := TypedPropertiesModel{
+		TextProperties:    types.MapValueMust(types.StringType, map[string]attr.Value{"owner": types.StringValue("platform")}),
+		NumberProperties:  types.MapValueMust(types.NumberType, map[string]attr.Value{"cpu": types.NumberValue(configuredCPU)}),
+		BooleanProperties: types.MapNull(types.BoolType),
+		ListProperties:    types.MapNull(types.ListType{ElemType: types.StringType}),
+		DateProperties:    types.MapNull(types.StringType),
+	}
+	properties := map[string]interface{}{"owner": "data", "cpu": 0.1, "tier": "Tier-1"}
+
+	typed, rest := splitTypedProperties(old, properties)
+
+	if got := typed.TextProperties.Elements()["owner"]; !got.Equal(types.StringValue("data")) {
+		t.Errorf("Expected the API's owner in text_properties, got %v", typed.TextProperties)
+	}
+	if got := typed.NumberProperties.Elements()["cpu"]; !got.Equal(types.NumberValue(configuredCPU)) {
+		t.Errorf("Expected the configured cpu to be kept, got %v", got)
+	}
+	if !typed.BooleanProperties.IsNull() {
+		t.Errorf("Expected boolean_properties to stay null, got %v", typed.BooleanProperties)
+	}
+	if len(rest) != 1 || rest["tier"] != "Tier-1" {
+		t.Errorf("Expected only tier to be left for properties, got %v", rest)
+	}
+}
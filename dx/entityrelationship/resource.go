@@ -0,0 +1,204 @@
+package entityrelationship
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-dx/dx"
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &EntityRelationshipResource{}
+	_ resource.ResourceWithImportState = &EntityRelationshipResource{}
+	_ resource.ResourceWithModifyPlan  = &EntityRelationshipResource{}
+)
+
+func NewEntityRelationshipResource() resource.Resource {
+	return &EntityRelationshipResource{}
+}
+
+type EntityRelationshipResource struct {
+	client *dxapi.Client
+}
+
+func (r *EntityRelationshipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_relationship"
+}
+
+func (r *EntityRelationshipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dxapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dxapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+// ModifyPlan validates new relationships against their relation definition, so mismatched entity
+// types are reported by `terraform plan` rather than halfway through an apply.
+func (r *EntityRelationshipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan EntityRelationshipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.RelationIdentifier.IsUnknown() || plan.SourceEntityIdentifier.IsUnknown() || plan.TargetEntityIdentifier.IsUnknown() {
+		return
+	}
+
+	// Every attribute forces replacement, and Terraform plans the replacement again as a create,
+	// so only new relationships need checking
+	if !req.State.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateRelationship(ctx, r.client, plan, false)...)
+}
+
+func (r *EntityRelationshipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating entity relationship resource")
+
+	var plan EntityRelationshipModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The relation or entities may have been created in this apply, after the plan was checked
+	resp.Diagnostics.Append(validateRelationship(ctx, r.client, plan, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := map[string]interface{}{
+		"relation_identifier":      plan.RelationIdentifier.ValueString(),
+		"source_entity_identifier": plan.SourceEntityIdentifier.ValueString(),
+		"target_entity_identifier": plan.TargetEntityIdentifier.ValueString(),
+	}
+
+	apiResp, err := r.client.CreateEntityRelationship(ctx, payload)
+	if err != nil {
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error creating entity relationship", err)
+		return
+	}
+
+	responseToModel(apiResp, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *EntityRelationshipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading entity relationship resource")
+
+	var state EntityRelationshipModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.GetEntityRelationship(ctx, state.RelationIdentifier.ValueString(), state.SourceEntityIdentifier.ValueString(), state.TargetEntityIdentifier.ValueString())
+	if err != nil {
+		if dxapi.IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Entity relationship %s not found, removing from state", state.Id.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading entity relationship",
+			fmt.Sprintf("Could not read entity relationship %s: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	responseToModel(apiResp, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called with changes, because every configurable attribute forces replacement.
+func (r *EntityRelationshipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EntityRelationshipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *EntityRelationshipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EntityRelationshipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	success, err := r.client.DeleteEntityRelationship(ctx, state.RelationIdentifier.ValueString(), state.SourceEntityIdentifier.ValueString(), state.TargetEntityIdentifier.ValueString())
+	if err != nil {
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error deleting entity relationship", err)
+		return
+	}
+	if !success {
+		resp.Diagnostics.AddError("Error deleting entity relationship", "API did not confirm deletion.")
+		return
+	}
+}
+
+// ImportState imports a relationship by its ID, `<relation_identifier>:<source_entity_identifier>:<target_entity_identifier>`.
+func (r *EntityRelationshipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing entity relationship state")
+
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form <relation_identifier>:<source_entity_identifier>:<target_entity_identifier>, got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("relation_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_entity_identifier"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_entity_identifier"), parts[2])...)
+}
+
+func responseToModel(apiResp *dxapi.APIEntityRelationshipResponse, state *EntityRelationshipModel) {
+	edge := apiResp.EntityRelationship
+	state.Id = types.StringValue(relationshipID(edge.RelationIdentifier, edge.SourceEntityIdentifier, edge.TargetEntityIdentifier))
+	state.RelationIdentifier = types.StringValue(edge.RelationIdentifier)
+	state.SourceEntityIdentifier = types.StringValue(edge.SourceEntityIdentifier)
+	state.TargetEntityIdentifier = types.StringValue(edge.TargetEntityIdentifier)
+	state.CreatedAt = types.StringValue(edge.CreatedAt)
+}
+
+func relationshipID(relationIdentifier, sourceEntityIdentifier, targetEntityIdentifier string) string {
+	return strings.Join([]string{relationIdentifier, sourceEntityIdentifier, targetEntityIdentifier}, ":")
+}
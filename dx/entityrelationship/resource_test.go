@@ -0,0 +1,137 @@
+package entityrelationship_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-dx/internal/acctest"
+)
+
+func TestAccDxEntityRelationshipResource(t *testing.T) {
+	suffix := acctest.RandInt()
+	relationIdentifier := fmt.Sprintf("tf_test_depends_on_%d", suffix)
+	entityA := fmt.Sprintf("tf-test-rel-a-%d", suffix)
+	entityB := fmt.Sprintf("tf-test-rel-b-%d", suffix)
+	entityC := fmt.Sprintf("tf-test-rel-c-%d", suffix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the relation, entities and relationship in a single apply
+			{
+				Config: testAccEntityRelationshipConfig(relationIdentifier, suffix, `
+resource "dx_entity_relationship" "test" {
+  relation_identifier      = dx_catalog_relation.test.identifier
+  source_entity_identifier = dx_entity.a.identifier
+  target_entity_identifier = dx_entity.b.identifier
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dx_entity_relationship.test", "id", fmt.Sprintf("%s:%s:%s", relationIdentifier, entityA, entityB)),
+					resource.TestCheckResourceAttr("dx_entity_relationship.test", "relation_identifier", relationIdentifier),
+					resource.TestCheckResourceAttr("dx_entity_relationship.test", "source_entity_identifier", entityA),
+					resource.TestCheckResourceAttr("dx_entity_relationship.test", "target_entity_identifier", entityB),
+					resource.TestCheckResourceAttrSet("dx_entity_relationship.test", "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "dx_entity_relationship.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// A second target for the same source breaks the many_to_one cardinality
+			{
+				Config: testAccEntityRelationshipConfig(relationIdentifier, suffix, `
+resource "dx_entity_relationship" "test" {
+  relation_identifier      = dx_catalog_relation.test.identifier
+  source_entity_identifier = dx_entity.a.identifier
+  target_entity_identifier = dx_entity.b.identifier
+}
+
+resource "dx_entity_relationship" "second" {
+  relation_identifier      = dx_catalog_relation.test.identifier
+  source_entity_identifier = dx_entity.a.identifier
+  target_entity_identifier = dx_entity.c.identifier
+}
+`),
+				ExpectError: regexp.MustCompile("Relationship exceeds relation cardinality"),
+			},
+			// Moving the relationship to another target replaces it without tripping the cardinality check
+			{
+				Config: testAccEntityRelationshipConfig(relationIdentifier, suffix, `
+resource "dx_entity_relationship" "test" {
+  relation_identifier      = dx_catalog_relation.test.identifier
+  source_entity_identifier = dx_entity.a.identifier
+  target_entity_identifier = dx_entity.c.identifier
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dx_entity_relationship.test", "target_entity_identifier", entityC),
+				),
+			},
+			// The source must have the relation's source entity type
+			{
+				Config: testAccEntityRelationshipConfig(relationIdentifier, suffix, `
+resource "dx_entity_relationship" "test" {
+  relation_identifier      = dx_catalog_relation.test.identifier
+  source_entity_identifier = dx_entity.a.identifier
+  target_entity_identifier = dx_entity.c.identifier
+}
+
+resource "dx_entity_relationship" "mismatched" {
+  relation_identifier      = dx_catalog_relation.test.identifier
+  source_entity_identifier = dx_entity.team.identifier
+  target_entity_identifier = dx_entity.b.identifier
+}
+`),
+				ExpectError: regexp.MustCompile("Entity type does not match relation"),
+			},
+		},
+	})
+}
+
+// testAccEntityRelationshipConfig returns a many_to_one service dependency relation, three
+// services a, b and c and an entity of another type, followed by relationships.
+func testAccEntityRelationshipConfig(relationIdentifier string, suffix int, relationships string) string {
+	return fmt.Sprintf(`
+provider "dx" {}
+
+resource "dx_entity_type" "team" {
+  identifier = "tf_test_rel_team_%[2]d"
+  name       = "Relationship Test Team"
+}
+
+resource "dx_catalog_relation" "test" {
+  identifier                    = "%[1]s"
+  type                          = "depends on"
+  cardinality                   = "many_to_one"
+  source_entity_type_identifier = "service"
+  target_entity_type_identifier = "service"
+}
+
+resource "dx_entity" "a" {
+  identifier = "tf-test-rel-a-%[2]d"
+  type       = "service"
+}
+
+resource "dx_entity" "b" {
+  identifier = "tf-test-rel-b-%[2]d"
+  type       = "service"
+}
+
+resource "dx_entity" "c" {
+  identifier = "tf-test-rel-c-%[2]d"
+  type       = "service"
+}
+
+resource "dx_entity" "team" {
+  identifier = "tf-test-rel-team-%[2]d"
+  type       = dx_entity_type.team.identifier
+}
+%[3]s`, relationIdentifier, suffix, relationships)
+}
@@ -0,0 +1,55 @@
+package entityrelationship
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func (r *EntityRelationshipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a relationship between two DX catalog entities, e.g. that one service depends on another. " +
+			"The relationship is typed by a `dx_catalog_relation` definition: the entities must have the definition's source and target entity types, " +
+			"and the relationship must respect its cardinality. Both are checked at plan time when the entities and relation already exist.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the relationship, in the form `<relation_identifier>:<source_entity_identifier>:<target_entity_identifier>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"relation_identifier": schema.StringAttribute{
+				Required:    true,
+				Description: "Identifier of the `dx_catalog_relation` definition the relationship is an instance of. Changing this forces a new relationship.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_entity_identifier": schema.StringAttribute{
+				Required:    true,
+				Description: "Identifier of the entity on the source side of the relationship. Changing this forces a new relationship.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_entity_identifier": schema.StringAttribute{
+				Required:    true,
+				Description: "Identifier of the entity on the target side of the relationship. Changing this forces a new relationship.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the relationship was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,156 @@
+package entityrelationship
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// validateRelationship checks a relationship against its relation definition: the entities must
+// have the definition's source and target entity types, and the relationship must not connect
+// the "one" side of the definition's cardinality to a second entity.
+//
+// At plan time the relation definition and entities may not exist yet because they are created in
+// the same apply, so missing objects are only reported when applying. Cardinality violations are
+// only warnings at plan time, because the conflicting relationship may be destroyed earlier in the
+// same apply, e.g. when a relationship is moved to another target.
+func validateRelationship(ctx context.Context, client *dxapi.Client, plan EntityRelationshipModel, applying bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	relationIdentifier := plan.RelationIdentifier.ValueString()
+	relResp, err := client.GetRelation(ctx, relationIdentifier)
+	if err != nil {
+		if !dxapi.IsNotFound(err) {
+			diags.AddError("Error reading catalog relation", err.Error())
+		} else if applying {
+			diags.AddAttributeError(path.Root("relation_identifier"), "Catalog relation not found", fmt.Sprintf("No catalog relation with identifier %q exists.", relationIdentifier))
+		}
+		return diags
+	}
+	rel := relResp.Relation
+
+	source := lookupEntity(ctx, client, path.Root("source_entity_identifier"), plan.SourceEntityIdentifier.ValueString(), applying, &diags)
+	target := lookupEntity(ctx, client, path.Root("target_entity_identifier"), plan.TargetEntityIdentifier.ValueString(), applying, &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	var edges []dxapi.APIEntityRelationship
+	listEdges := func(opts dxapi.ListEntityRelationshipsOptions) bool {
+		for edge, err := range client.IterateEntityRelationships(ctx, &opts) {
+			if err != nil {
+				diags.AddError("Error listing entity relationships", err.Error())
+				return false
+			}
+			edges = append(edges, edge)
+		}
+		return true
+	}
+	if source != nil && limitsSource(rel.Cardinality) {
+		if !listEdges(dxapi.ListEntityRelationshipsOptions{RelationIdentifier: rel.Identifier, SourceEntityIdentifier: source.Identifier}) {
+			return diags
+		}
+	}
+	if target != nil && limitsTarget(rel.Cardinality) {
+		if !listEdges(dxapi.ListEntityRelationshipsOptions{RelationIdentifier: rel.Identifier, TargetEntityIdentifier: target.Identifier}) {
+			return diags
+		}
+	}
+
+	diags.Append(checkEntityTypes(rel, source, target)...)
+	severity := diag.SeverityError
+	if !applying {
+		severity = diag.SeverityWarning
+	}
+	diags.Append(checkCardinality(rel, plan, edges, severity)...)
+	return diags
+}
+
+// lookupEntity returns the entity with the given identifier, or nil if it does not exist or cannot
+// be read. Failures are added to diags.
+func lookupEntity(ctx context.Context, client *dxapi.Client, attr path.Path, identifier string, requireExisting bool, diags *diag.Diagnostics) *dxapi.APIEntity {
+	apiResp, err := client.GetEntity(ctx, identifier)
+	if err != nil {
+		if !dxapi.IsNotFound(err) {
+			diags.AddError("Error reading entity", err.Error())
+		} else if requireExisting {
+			diags.AddAttributeError(attr, "Entity not found", fmt.Sprintf("No entity with identifier %q exists.", identifier))
+		}
+		return nil
+	}
+	return &apiResp.Entity
+}
+
+// checkEntityTypes reports source and target entities that do not have the entity types of the
+// relation definition rel. A nil entity is not checked.
+func checkEntityTypes(rel dxapi.APIRelation, source, target *dxapi.APIEntity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if source != nil && source.Type != rel.SourceEntityTypeIdentifier {
+		diags.AddAttributeError(
+			path.Root("source_entity_identifier"),
+			"Entity type does not match relation",
+			fmt.Sprintf("The source of a %q relationship must be a %q entity, but %q is a %q entity.", rel.Identifier, rel.SourceEntityTypeIdentifier, source.Identifier, source.Type),
+		)
+	}
+	if target != nil && target.Type != rel.TargetEntityTypeIdentifier {
+		diags.AddAttributeError(
+			path.Root("target_entity_identifier"),
+			"Entity type does not match relation",
+			fmt.Sprintf("The target of a %q relationship must be a %q entity, but %q is a %q entity.", rel.Identifier, rel.TargetEntityTypeIdentifier, target.Identifier, target.Type),
+		)
+	}
+
+	return diags
+}
+
+// checkCardinality reports, with the given severity, existing relationships that the planned one
+// would break the cardinality of the relation definition rel with. edges are the existing
+// relationships of rel that start at the planned source or end at the planned target.
+func checkCardinality(rel dxapi.APIRelation, plan EntityRelationshipModel, edges []dxapi.APIEntityRelationship, severity diag.Severity) diag.Diagnostics {
+	var diags diag.Diagnostics
+	add := func(attr, detail string) {
+		if severity == diag.SeverityWarning {
+			detail += " The apply will fail unless that relationship is removed first."
+			diags.AddAttributeWarning(path.Root(attr), "Relationship exceeds relation cardinality", detail)
+			return
+		}
+		diags.AddAttributeError(path.Root(attr), "Relationship exceeds relation cardinality", detail)
+	}
+
+	sourceIdentifier := plan.SourceEntityIdentifier.ValueString()
+	targetIdentifier := plan.TargetEntityIdentifier.ValueString()
+	for _, edge := range edges {
+		if edge.RelationIdentifier != rel.Identifier || sameEdge(edge, plan) {
+			continue
+		}
+		if limitsSource(rel.Cardinality) && edge.SourceEntityIdentifier == sourceIdentifier {
+			add("source_entity_identifier", fmt.Sprintf("The %q relation is %s, so %q can only have one target, but it already has a relationship to %q.", rel.Identifier, rel.Cardinality, sourceIdentifier, edge.TargetEntityIdentifier))
+		}
+		if limitsTarget(rel.Cardinality) && edge.TargetEntityIdentifier == targetIdentifier {
+			add("target_entity_identifier", fmt.Sprintf("The %q relation is %s, so %q can only have one source, but it already has a relationship from %q.", rel.Identifier, rel.Cardinality, targetIdentifier, edge.SourceEntityIdentifier))
+		}
+	}
+
+	return diags
+}
+
+// limitsSource reports whether a source entity may have at most one target under cardinality.
+func limitsSource(cardinality string) bool {
+	return cardinality == "one_to_one" || cardinality == "many_to_one"
+}
+
+// limitsTarget reports whether a target entity may have at most one source under cardinality.
+func limitsTarget(cardinality string) bool {
+	return cardinality == "one_to_one" || cardinality == "one_to_many"
+}
+
+func sameEdge(edge dxapi.APIEntityRelationship, model EntityRelationshipModel) bool {
+	return edge.RelationIdentifier == model.RelationIdentifier.ValueString() &&
+		edge.SourceEntityIdentifier == model.SourceEntityIdentifier.ValueString() &&
+		edge.TargetEntityIdentifier == model.TargetEntityIdentifier.ValueString()
+}
@@ -0,0 +1,83 @@
+package entityrelationship
+
+import (
+	"strings"
+	"testing"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestCheckRelationship verifies that relationships are checked against the entity types and
+// cardinality of their relation definition.
+func TestCheckRelationship(t *testing.T) {
+	rel := dxapi.APIRelation{
+		Identifier:                 "owned_by",
+		Cardinality:                "many_to_one",
+		SourceEntityTypeIdentifier: "service",
+		TargetEntityTypeIdentifier: "team",
+	}
+	plan := EntityRelationshipModel{
+		RelationIdentifier:     types.StringValue("owned_by"),
+		SourceEntityIdentifier: types.StringValue("checkout"),
+		TargetEntityIdentifier: types.StringValue("payments"),
+	}
+	service := &dxapi.APIEntity{Identifier: "checkout", Type: "service"}
+	team := &dxapi.APIEntity{Identifier: "payments", Type: "team"}
+	edge := func(source, target string) dxapi.APIEntityRelationship {
+		return dxapi.APIEntityRelationship{RelationIdentifier: "owned_by", SourceEntityIdentifier: source, TargetEntityIdentifier: target}
+	}
+
+	tests := []struct {
+		name           string
+		source, target *dxapi.APIEntity
+		edges          []dxapi.APIEntityRelationship
+		wantError      string
+	}{
+		{name: "valid", source: service, target: team},
+		{name: "entities not created yet", source: nil, target: nil},
+		{name: "source of the wrong type", source: team, target: team, wantError: `must be a "service" entity`},
+		{name: "target of the wrong type", source: service, target: service, wantError: `must be a "team" entity`},
+		{name: "many sources for one target", source: service, target: team, edges: []dxapi.APIEntityRelationship{edge("cart", "payments")}},
+		{name: "second target for the source", source: service, target: team, edges: []dxapi.APIEntityRelationship{edge("checkout", "search")}, wantError: "can only have one target"},
+		{name: "relationship already exists", source: service, target: team, edges: []dxapi.APIEntityRelationship{edge("checkout", "payments")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := checkEntityTypes(rel, tt.source, tt.target)
+			diags.Append(checkCardinality(rel, plan, tt.edges, diag.SeverityError)...)
+
+			if tt.wantError == "" {
+				if diags.HasError() {
+					t.Errorf("Expected no errors, got: %v", diags)
+				}
+				return
+			}
+			if diags.ErrorsCount() != 1 || !strings.Contains(diags.Errors()[0].Detail(), tt.wantError) {
+				t.Errorf("Expected one error containing %q, got: %v", tt.wantError, diags)
+			}
+		})
+	}
+}
+
+// TestCheckCardinalityWarnsAtPlanTime verifies that cardinality violations are only warnings at
+// plan time, because the conflicting relationship may be destroyed earlier in the same apply.
+func TestCheckCardinalityWarnsAtPlanTime(t *testing.T) {
+	rel := dxapi.APIRelation{Identifier: "parent_of", Cardinality: "one_to_many"}
+	plan := EntityRelationshipModel{
+		RelationIdentifier:     types.StringValue("parent_of"),
+		SourceEntityIdentifier: types.StringValue("platform"),
+		TargetEntityIdentifier: types.StringValue("checkout"),
+	}
+	edges := []dxapi.APIEntityRelationship{
+		{RelationIdentifier: "parent_of", SourceEntityIdentifier: "payments", TargetEntityIdentifier: "checkout"},
+	}
+
+	diags := checkCardinality(rel, plan, edges, diag.SeverityWarning)
+	if diags.HasError() || diags.WarningsCount() != 1 || !strings.Contains(diags.Warnings()[0].Detail(), "can only have one source") {
+		t.Errorf("Expected a single cardinality warning, got: %v", diags)
+	}
+}
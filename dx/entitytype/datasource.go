@@ -0,0 +1,95 @@
+package entitytype
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &EntityTypeDataSource{}
+	_ datasource.DataSourceWithConfigure = &EntityTypeDataSource{}
+)
+
+func NewEntityTypeDataSource() datasource.DataSource {
+	return &EntityTypeDataSource{}
+}
+
+// EntityTypeDataSource defines the data source implementation.
+type EntityTypeDataSource struct {
+	client *dxapi.Client
+}
+
+func (d *EntityTypeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_type"
+}
+
+func (d *EntityTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := dataSourceEntityTypeSchema()
+	attributes["identifier"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The unique identifier of the entity type to look up.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reads a DX Entity Type. Use this to discover the properties and aliases an entity type supports without managing it.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *EntityTypeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dxapi.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dxapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (d *EntityTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading entity type data source")
+
+	var config EntityTypeModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identifier := config.Identifier.ValueString()
+	apiResp, err := d.client.GetEntityType(ctx, identifier)
+	if err != nil {
+		if dxapi.IsNotFound(err) {
+			resp.Diagnostics.AddError("Entity type not found", fmt.Sprintf("No entity type with identifier %q exists.", identifier))
+			return
+		}
+		resp.Diagnostics.AddError("Error reading entity type", err.Error())
+		return
+	}
+
+	var state EntityTypeModel
+	responseBodyToModel(ctx, apiResp, &state, &EntityTypeModel{})
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
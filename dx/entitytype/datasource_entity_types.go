@@ -0,0 +1,91 @@
+package entitytype
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &EntityTypesDataSource{}
+	_ datasource.DataSourceWithConfigure = &EntityTypesDataSource{}
+)
+
+func NewEntityTypesDataSource() datasource.DataSource {
+	return &EntityTypesDataSource{}
+}
+
+type EntityTypesDataSource struct {
+	client *dxapi.Client
+}
+
+type EntityTypesDataSourceModel struct {
+	EntityTypes []EntityTypeModel `tfsdk:"entity_types"`
+}
+
+func (d *EntityTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_types"
+}
+
+func (d *EntityTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all DX entity types, including built-in ones such as `service`.",
+		Attributes: map[string]schema.Attribute{
+			"entity_types": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of entity types.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: dataSourceEntityTypeSchema(),
+				},
+			},
+		},
+	}
+}
+
+func (d *EntityTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dxapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dxapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (d *EntityTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading entity types data source")
+
+	state := EntityTypesDataSourceModel{
+		EntityTypes: []EntityTypeModel{},
+	}
+
+	for apiEntityType, err := range d.client.IterateEntityTypes(ctx, nil) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing entity types", err.Error())
+			return
+		}
+
+		var entityTypeModel EntityTypeModel
+		responseBodyToModel(ctx, &dxapi.APIEntityTypeResponse{Ok: true, EntityType: apiEntityType}, &entityTypeModel, &EntityTypeModel{})
+		state.EntityTypes = append(state.EntityTypes, entityTypeModel)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
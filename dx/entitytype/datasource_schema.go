@@ -0,0 +1,111 @@
+package entitytype
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The data source schemas mirror EntityTypeSchema with every attribute computed, so data sources
+// can reuse EntityTypeModel and responseBodyToModel.
+
+func dataSourcePropertySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Display name for the property.",
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: "Property type (e.g., 'multi_select', 'text', 'computed', 'url').",
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: "Description of the property.",
+		},
+		"visibility": schema.StringAttribute{
+			Computed:    true,
+			Description: "Property visibility setting, 'hidden' or 'visible'.",
+		},
+		"ordering": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Sort order for the property.",
+		},
+		"options": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Available options for multi_select properties.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"value": schema.StringAttribute{
+						Computed:    true,
+						Description: "The option value.",
+					},
+					"color": schema.StringAttribute{
+						Computed:    true,
+						Description: "Hex color code for the option.",
+					},
+				},
+			},
+		},
+		"sql": schema.StringAttribute{
+			Computed:    true,
+			Description: "SQL query for computed properties.",
+		},
+		"output_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "Output type for computed properties.",
+		},
+		"call_to_action": schema.StringAttribute{
+			Computed:    true,
+			Description: "Call-to-action text for url properties.",
+		},
+		"call_to_action_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "Call-to-action type for url properties, 'text' or 'icon'.",
+		},
+	}
+}
+
+func dataSourceEntityTypeSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the entity type (same as 'identifier').",
+		},
+		"identifier": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the entity type.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Display name for the entity type.",
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: "Detailed explanation of the entity type.",
+		},
+		"properties": schema.MapNestedAttribute{
+			Computed:    true,
+			Description: "Custom properties of the entity type, keyed by property identifier. These are the keys accepted in the `properties` of a `dx_entity` of this type.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: dataSourcePropertySchema(),
+			},
+		},
+		"aliases": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.BoolType,
+			Description: "Alias types and whether they are enabled for the entity type (e.g., 'github_repo': true).",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timestamp when the entity type was created.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timestamp when the entity type was last updated.",
+		},
+		"ordering": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Sort order for the entity type.",
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package entitytype_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-dx/internal/acctest"
+)
+
+func TestAccDxEntityTypeDataSource(t *testing.T) {
+	entityTypeIdentifier := fmt.Sprintf("tf_test_ds_%d", acctest.RandInt())
+	entityTypeName := fmt.Sprintf("Terraform Test DS Entity Type %d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create an entity type and then read it with the data sources
+			{
+				Config: testAccEntityTypeDataSourceConfig(entityTypeIdentifier, entityTypeName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dx_entity_type.test", "identifier", entityTypeIdentifier),
+					resource.TestCheckResourceAttr("data.dx_entity_type.test", "name", entityTypeName),
+					resource.TestCheckResourceAttr("data.dx_entity_type.test", "properties.%", "2"),
+					resource.TestCheckResourceAttr("data.dx_entity_type.test", "properties.team.type", "multi_select"),
+					resource.TestCheckResourceAttr("data.dx_entity_type.test", "properties.team.options.#", "2"),
+					resource.TestCheckResourceAttr("data.dx_entity_type.test", "aliases.github_repo", "true"),
+					resource.TestCheckResourceAttrPair("dx_entity_type.test", "created_at", "data.dx_entity_type.test", "created_at"),
+					resource.TestCheckResourceAttrPair("dx_entity_type.test", "ordering", "data.dx_entity_type.test", "ordering"),
+
+					resource.TestCheckTypeSetElemNestedAttrs("data.dx_entity_types.all", "entity_types.*", map[string]string{
+						"identifier": entityTypeIdentifier,
+						"name":       entityTypeName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccEntityTypeDataSourceConfig(identifier, name string) string {
+	return fmt.Sprintf(`
+provider "dx" {}
+
+resource "dx_entity_type" "test" {
+  identifier = "%s"
+  name       = "%s"
+
+  properties = {
+    team = {
+      name = "Owning Team"
+      type = "multi_select"
+      options = [
+        { value = "platform", color = "#3b82f6" },
+        { value = "data", color = "#ef4444" },
+      ]
+    }
+    tier = {
+      name = "Service Tier"
+      type = "text"
+    }
+  }
+
+  aliases = {
+    github_repo = true
+  }
+}
+
+data "dx_entity_type" "test" {
+  identifier = dx_entity_type.test.identifier
+}
+
+data "dx_entity_types" "all" {
+  depends_on = [dx_entity_type.test]
+}
+`, identifier, name)
+}
@@ -75,7 +75,7 @@ func (r *EntityTypeResource) Create(ctx context.Context, req resource.CreateRequ
 	// Create EntityType (apiResp is a struct of type APIEntityTypeResponse)
 	apiResp, err := r.client.CreateEntityType(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating entity type", err.Error())
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error creating entity type", err)
 		return
 	}
 
@@ -99,8 +99,6 @@ func (r *EntityTypeResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	tflog.Info(ctx, fmt.Sprintf("Prior state, before reading from API: %v", state))
-
 	// Extract identifier
 	identifier := state.Identifier.ValueString()
 	if identifier == "" {
@@ -111,6 +109,11 @@ func (r *EntityTypeResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Call the API to get the latest entity type data
 	apiResp, err := r.client.GetEntityType(ctx, identifier)
 	if err != nil {
+		if dxapi.IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Entity type %s not found, removing from state", identifier))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading entity type",
 			fmt.Sprintf("Could not read entity type with identifier %s: %s", identifier, err.Error()),
@@ -138,7 +141,7 @@ func (r *EntityTypeResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	apiResp, err := r.client.UpdateEntityType(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating entity type", err.Error())
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error updating entity type", err)
 		return
 	}
 
@@ -166,7 +169,7 @@ func (r *EntityTypeResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	success, err := r.client.DeleteEntityType(ctx, identifier)
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting entity type", err.Error())
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error deleting entity type", err)
 		return
 	}
 	if !success {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
+	"terraform-provider-dx/dx/dxapi"
 	"terraform-provider-dx/internal/acctest"
 )
 
@@ -172,3 +173,29 @@ resource "dx_entity_type" "minimal" {
 		},
 	})
 }
+
+func TestAccDxEntityTypeResourceDeletedOutOfBand(t *testing.T) {
+	entityTypeIdentifier := fmt.Sprintf("tf_disappears_%d", acctest.RandInt())
+
+	var testAccDxEntityTypeResourceDisappears = fmt.Sprintf(`
+provider "dx" {}
+
+resource "dx_entity_type" "disappears" {
+  identifier = "%s"
+  name       = "Disappearing Entity Type %s"
+}
+`, entityTypeIdentifier, entityTypeIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Deleting the entity type outside of Terraform should plan a re-create, not fail the refresh
+			{
+				Config:             testAccDxEntityTypeResourceDisappears,
+				Check:              acctest.CheckDeletedOutOfBand("dx_entity_type.disappears", "identifier", (*dxapi.Client).DeleteEntityType),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
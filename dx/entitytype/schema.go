@@ -3,6 +3,8 @@ package entitytype
 import (
 	"context"
 
+	"terraform-provider-dx/dx/sqllint"
+
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -75,7 +77,10 @@ func PropertySchema() map[string]schema.Attribute {
 		},
 		"sql": schema.StringAttribute{
 			Optional:    true,
-			Description: "SQL query for computed properties. Required when type is 'computed'.",
+			Description: "SQL query for computed properties. Required when type is 'computed'. The query must be a single read-only SELECT, and can use the `$entity_identifier` placeholder.",
+			Validators: []validator.String{
+				sqllint.Query(sqllint.ComputedPropertyRules),
+			},
 		},
 		"output_type": schema.StringAttribute{
 			Optional:    true,
@@ -0,0 +1,95 @@
+package relation
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &RelationDataSource{}
+	_ datasource.DataSourceWithConfigure = &RelationDataSource{}
+)
+
+func NewRelationDataSource() datasource.DataSource {
+	return &RelationDataSource{}
+}
+
+// RelationDataSource defines the data source implementation.
+type RelationDataSource struct {
+	client *dxapi.Client
+}
+
+func (d *RelationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_relation"
+}
+
+func (d *RelationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := dataSourceRelationSchema()
+	attributes["identifier"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The unique identifier of the relation definition to look up.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reads a DX Catalog Relation definition. Use this to reference relations defined elsewhere without managing them.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *RelationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dxapi.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dxapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (d *RelationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading catalog relation data source")
+
+	var config RelationModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identifier := config.Identifier.ValueString()
+	apiResp, err := d.client.GetRelation(ctx, identifier)
+	if err != nil {
+		if dxapi.IsNotFound(err) {
+			resp.Diagnostics.AddError("Catalog relation not found", fmt.Sprintf("No catalog relation with identifier %q exists.", identifier))
+			return
+		}
+		resp.Diagnostics.AddError("Error reading catalog relation", err.Error())
+		return
+	}
+
+	var state RelationModel
+	responseToModel(apiResp, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
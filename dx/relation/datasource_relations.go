@@ -0,0 +1,117 @@
+package relation
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &RelationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &RelationsDataSource{}
+)
+
+func NewRelationsDataSource() datasource.DataSource {
+	return &RelationsDataSource{}
+}
+
+type RelationsDataSource struct {
+	client *dxapi.Client
+}
+
+type RelationsDataSourceModel struct {
+	SourceEntityTypeIdentifier types.String    `tfsdk:"source_entity_type_identifier"`
+	TargetEntityTypeIdentifier types.String    `tfsdk:"target_entity_type_identifier"`
+	Relations                  []RelationModel `tfsdk:"relations"`
+}
+
+func (d *RelationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_relations"
+}
+
+func (d *RelationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists DX Catalog Relation definitions, optionally filtered by the entity types they connect.",
+		Attributes: map[string]schema.Attribute{
+			"source_entity_type_identifier": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return relations whose source side is this entity type.",
+			},
+			"target_entity_type_identifier": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return relations whose target side is this entity type.",
+			},
+			"relations": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The relation definitions matching every given filter.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: dataSourceRelationSchema(),
+				},
+			},
+		},
+	}
+}
+
+func (d *RelationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dxapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dxapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (d *RelationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading catalog relations data source")
+
+	var config RelationsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := RelationsDataSourceModel{
+		SourceEntityTypeIdentifier: config.SourceEntityTypeIdentifier,
+		TargetEntityTypeIdentifier: config.TargetEntityTypeIdentifier,
+		Relations:                  []RelationModel{},
+	}
+
+	for apiRelation, err := range d.client.IterateRelations(ctx, nil) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing catalog relations", err.Error())
+			return
+		}
+		if !config.SourceEntityTypeIdentifier.IsNull() && apiRelation.SourceEntityTypeIdentifier != config.SourceEntityTypeIdentifier.ValueString() {
+			continue
+		}
+		if !config.TargetEntityTypeIdentifier.IsNull() && apiRelation.TargetEntityTypeIdentifier != config.TargetEntityTypeIdentifier.ValueString() {
+			continue
+		}
+
+		var relationModel RelationModel
+		responseToModel(&dxapi.APIRelationResponse{Ok: true, Relation: apiRelation}, &relationModel)
+		state.Relations = append(state.Relations, relationModel)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,52 @@
+package relation
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// dataSourceRelationSchema mirrors the resource schema with every attribute computed, so data
+// sources can reuse RelationModel and responseToModel.
+func dataSourceRelationSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the relation (same as 'identifier').",
+		},
+		"identifier": schema.StringAttribute{
+			Computed:    true,
+			Description: "Unique identifier for the relation definition.",
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: "Relation type.",
+		},
+		"inverse_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The inverse relation type, derived automatically by the API.",
+		},
+		"cardinality": schema.StringAttribute{
+			Computed:    true,
+			Description: "Cardinality constraint.",
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: "Human-readable description of the relation.",
+		},
+		"source_entity_type_identifier": schema.StringAttribute{
+			Computed:    true,
+			Description: "Entity type identifier for the source side of the relation.",
+		},
+		"target_entity_type_identifier": schema.StringAttribute{
+			Computed:    true,
+			Description: "Entity type identifier for the target side of the relation.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timestamp when the relation was created.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timestamp when the relation was last updated.",
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package relation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-dx/internal/acctest"
+)
+
+func TestAccDxCatalogRelationDataSource(t *testing.T) {
+	entityTypeIdentifier := fmt.Sprintf("tf_test_rel_ds_et_%d", acctest.RandInt())
+	relationIdentifier := fmt.Sprintf("tf_test_rel_ds_%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a relation and then read it by identifier and in filtered lists
+			{
+				Config: testAccCatalogRelationDataSourceConfig(entityTypeIdentifier, relationIdentifier),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dx_catalog_relation.test", "identifier", relationIdentifier),
+					resource.TestCheckResourceAttr("data.dx_catalog_relation.test", "type", "manages"),
+					resource.TestCheckResourceAttr("data.dx_catalog_relation.test", "cardinality", "one_to_many"),
+					resource.TestCheckResourceAttr("data.dx_catalog_relation.test", "source_entity_type_identifier", "service"),
+					resource.TestCheckResourceAttr("data.dx_catalog_relation.test", "target_entity_type_identifier", entityTypeIdentifier),
+					resource.TestCheckResourceAttrPair("dx_catalog_relation.test", "inverse_type", "data.dx_catalog_relation.test", "inverse_type"),
+					resource.TestCheckResourceAttrPair("dx_catalog_relation.test", "created_at", "data.dx_catalog_relation.test", "created_at"),
+
+					resource.TestCheckResourceAttr("data.dx_catalog_relations.by_target", "relations.#", "1"),
+					resource.TestCheckResourceAttr("data.dx_catalog_relations.by_target", "relations.0.identifier", relationIdentifier),
+					resource.TestCheckResourceAttr("data.dx_catalog_relations.by_source_and_target", "relations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCatalogRelationDataSourceConfig(entityTypeIdentifier, relationIdentifier string) string {
+	return fmt.Sprintf(`
+provider "dx" {}
+
+resource "dx_entity_type" "test" {
+  identifier = "%s"
+  name       = "Relation Data Source Test Type"
+}
+
+resource "dx_catalog_relation" "test" {
+  identifier                    = "%s"
+  type                          = "manages"
+  cardinality                   = "one_to_many"
+  source_entity_type_identifier = "service"
+  target_entity_type_identifier = dx_entity_type.test.identifier
+}
+
+data "dx_catalog_relation" "test" {
+  identifier = dx_catalog_relation.test.identifier
+}
+
+data "dx_catalog_relations" "by_target" {
+  target_entity_type_identifier = dx_entity_type.test.identifier
+
+  depends_on = [dx_catalog_relation.test]
+}
+
+data "dx_catalog_relations" "by_source_and_target" {
+  source_entity_type_identifier = dx_entity_type.test.identifier
+  target_entity_type_identifier = dx_entity_type.test.identifier
+
+  depends_on = [dx_catalog_relation.test]
+}
+`, entityTypeIdentifier, relationIdentifier)
+}
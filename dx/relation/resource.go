@@ -2,7 +2,6 @@ package relation
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"terraform-provider-dx/dx"
@@ -66,7 +65,7 @@ func (r *RelationResource) Create(ctx context.Context, req resource.CreateReques
 
 	apiResp, err := r.client.CreateRelation(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating catalog relation", err.Error())
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error creating catalog relation", err)
 		return
 	}
 
@@ -94,8 +93,7 @@ func (r *RelationResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	apiResp, err := r.client.GetRelation(ctx, identifier)
 	if err != nil {
-		var notFound *dxapi.RelationNotFoundError
-		if errors.As(err, &notFound) {
+		if dxapi.IsNotFound(err) {
 			tflog.Info(ctx, fmt.Sprintf("Catalog relation %s not found, removing from state", identifier))
 			resp.State.RemoveResource(ctx)
 			return
@@ -126,7 +124,7 @@ func (r *RelationResource) Update(ctx context.Context, req resource.UpdateReques
 
 	apiResp, err := r.client.UpdateRelation(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating catalog relation", err.Error())
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error updating catalog relation", err)
 		return
 	}
 
@@ -151,7 +149,7 @@ func (r *RelationResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	success, err := r.client.DeleteRelation(ctx, identifier)
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting catalog relation", err.Error())
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error deleting catalog relation", err)
 		return
 	}
 	if !success {
@@ -0,0 +1,151 @@
+package scorecard
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ScorecardDataSource{}
+	_ datasource.DataSourceWithConfigure = &ScorecardDataSource{}
+)
+
+func NewScorecardDataSource() datasource.DataSource {
+	return &ScorecardDataSource{}
+}
+
+// ScorecardDataSource defines the data source implementation.
+type ScorecardDataSource struct {
+	client *dxapi.Client
+}
+
+func (d *ScorecardDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scorecard"
+}
+
+func (d *ScorecardDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := dataSourceScorecardSchema()
+	attributes["id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the scorecard to look up. Exactly one of `id` or `name` must be set.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The name of the scorecard to look up. The name must match exactly one scorecard.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reads a DX Scorecard. Use this to reference existing scorecards, e.g. their levels or checks, without managing them.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *ScorecardDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dxapi.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dxapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (d *ScorecardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading scorecard data source")
+
+	var config ScorecardModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiScorecard *dxapi.APIScorecard
+	if !config.Id.IsNull() {
+		apiResp, err := d.client.GetScorecard(ctx, config.Id.ValueString())
+		if err != nil {
+			if dxapi.IsNotFound(err) {
+				resp.Diagnostics.AddError("Scorecard not found", fmt.Sprintf("No scorecard with ID %q exists.", config.Id.ValueString()))
+				return
+			}
+			resp.Diagnostics.AddError("Error reading scorecard", err.Error())
+			return
+		}
+		apiScorecard = &apiResp.Scorecard
+	} else {
+		name := config.Name.ValueString()
+		var matches []dxapi.APIScorecard
+		for sc, err := range d.client.IterateScorecards(ctx, nil) {
+			if err != nil {
+				resp.Diagnostics.AddError("Error listing scorecards", err.Error())
+				return
+			}
+			if sc.Name == name {
+				matches = append(matches, sc)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddError("Scorecard not found", fmt.Sprintf("No scorecard is named %q.", name))
+			return
+		case 1:
+			apiScorecard = &matches[0]
+		default:
+			ids := make([]string, 0, len(matches))
+			for _, sc := range matches {
+				ids = append(ids, sc.Id)
+			}
+			resp.Diagnostics.AddError(
+				"Multiple scorecards found",
+				fmt.Sprintf("%d scorecards are named %q (IDs: %s). Look the scorecard up by `id` instead.", len(matches), name, strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	state := apiScorecardToDataSourceModel(ctx, apiScorecard)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// apiScorecardToDataSourceModel maps a scorecard to state for data sources, which have no prior
+// state. Level, check group and check keys are the snake cased names.
+func apiScorecardToDataSourceModel(ctx context.Context, apiScorecard *dxapi.APIScorecard) ScorecardModel {
+	var state ScorecardModel
+	responseBodyToModel(ctx, &dxapi.APIResponse{Ok: true, Scorecard: *apiScorecard}, &state, &ScorecardModel{})
+
+	// Data sources always report whether the scorecard is published, rather than null for false
+	state.Published = types.BoolValue(apiScorecard.Published)
+	return state
+}
@@ -0,0 +1,267 @@
+package scorecard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                   = &CheckPreviewDataSource{}
+	_ datasource.DataSourceWithConfigure      = &CheckPreviewDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &CheckPreviewDataSource{}
+)
+
+// defaultSampleSize is the number of entities a check is evaluated against when `sample_size`
+// is not set.
+const defaultSampleSize = 100
+
+func NewCheckPreviewDataSource() datasource.DataSource {
+	return &CheckPreviewDataSource{}
+}
+
+// CheckPreviewDataSource evaluates a check without saving it, so plans can show how many entities
+// a new or changed check would pass or fail.
+type CheckPreviewDataSource struct {
+	client *dxapi.Client
+}
+
+type CheckPreviewDataSourceModel struct {
+	ScorecardId                 types.String   `tfsdk:"scorecard_id"`
+	EntityFilterTypeIdentifiers []types.String `tfsdk:"entity_filter_type_identifiers"`
+	EntityFilterSql             types.String   `tfsdk:"entity_filter_sql"`
+	SampleSize                  types.Int64    `tfsdk:"sample_size"`
+	Check                       CheckModel     `tfsdk:"check"`
+
+	Results       []CheckPreviewResultModel `tfsdk:"results"`
+	PassCount     types.Int64               `tfsdk:"pass_count"`
+	WarnCount     types.Int64               `tfsdk:"warn_count"`
+	FailCount     types.Int64               `tfsdk:"fail_count"`
+	ExcludedCount types.Int64               `tfsdk:"excluded_count"`
+}
+
+type CheckPreviewResultModel struct {
+	EntityIdentifier types.String `tfsdk:"entity_identifier"`
+	EntityName       types.String `tfsdk:"entity_name"`
+	Status           types.String `tfsdk:"status"`
+	Output           types.String `tfsdk:"output"`
+	Message          types.String `tfsdk:"message"`
+}
+
+func (d *CheckPreviewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scorecard_check_preview"
+}
+
+// previewCheckSchema has the attributes of a check, so a check from the `checks` of a
+// dx_scorecard can be previewed as is. Only the queries and output settings are evaluated.
+func previewCheckSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id":                 schema.StringAttribute{Optional: true},
+		"name":               schema.StringAttribute{Optional: true},
+		"description":        schema.StringAttribute{Optional: true},
+		"ordering":           schema.Int32Attribute{Optional: true},
+		"sql":                schema.StringAttribute{Required: true, Description: "The query to evaluate for each entity."},
+		"filter_sql":         schema.StringAttribute{Optional: true, Description: "The query deciding which entities the check applies to."},
+		"filter_message":     schema.StringAttribute{Optional: true},
+		"output_enabled":     schema.BoolAttribute{Optional: true, Description: "Whether to return the `output` column of the query."},
+		"output_type":        schema.StringAttribute{Optional: true},
+		"output_aggregation": schema.StringAttribute{Optional: true},
+		"output_custom_options": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"unit":     schema.StringAttribute{Required: true},
+				"decimals": schema.Int32Attribute{Optional: true},
+			},
+		},
+		"estimated_dev_days":        schema.Float32Attribute{Optional: true},
+		"external_url":              schema.StringAttribute{Optional: true},
+		"published":                 schema.BoolAttribute{Optional: true},
+		"scorecard_level_key":       schema.StringAttribute{Optional: true},
+		"scorecard_check_group_key": schema.StringAttribute{Optional: true},
+		"points":                    schema.Int32Attribute{Optional: true},
+	}
+}
+
+func (d *CheckPreviewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Evaluates a scorecard check against a sample of entities without saving it, e.g. to show in a plan how many entities a new or changed check will pass or fail.",
+		Attributes: map[string]schema.Attribute{
+			"scorecard_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Evaluate the check against the entities this scorecard assesses.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("entity_filter_type_identifiers"), path.MatchRoot("entity_filter_sql")),
+				},
+			},
+			"entity_filter_type_identifiers": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Evaluate the check against entities of these types.",
+			},
+			"entity_filter_sql": schema.StringAttribute{
+				Optional:    true,
+				Description: "Evaluate the check against the entities this query selects, like the `entity_filter_sql` of a scorecard.",
+			},
+			"sample_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The maximum number of entities to evaluate the check against. Defaults to %d.", defaultSampleSize),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
+			},
+			"check": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "The check to evaluate. Only `sql`, `filter_sql`, `output_enabled` and `output_type` are used. The other check attributes are accepted, so a check from the `checks` of a `dx_scorecard` can be passed as is.",
+				Attributes:  previewCheckSchema(),
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The result of the check for each evaluated entity.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"entity_identifier": schema.StringAttribute{Computed: true, Description: "The identifier of the entity."},
+						"entity_name":       schema.StringAttribute{Computed: true, Description: "The name of the entity."},
+						"status":            schema.StringAttribute{Computed: true, Description: "The status the check returned: PASS, WARN or FAIL. Null when the check's `filter_sql` excluded the entity."},
+						"output":            schema.StringAttribute{Computed: true, Description: "The output the check returned, when `output_enabled` is set. Numbers are returned as strings."},
+						"message":           schema.StringAttribute{Computed: true, Description: "A message from DX about the evaluation, e.g. why the entity was excluded."},
+					},
+				},
+			},
+			"pass_count":     schema.Int64Attribute{Computed: true, Description: "The number of evaluated entities that pass the check."},
+			"warn_count":     schema.Int64Attribute{Computed: true, Description: "The number of evaluated entities with a warning."},
+			"fail_count":     schema.Int64Attribute{Computed: true, Description: "The number of evaluated entities that fail the check."},
+			"excluded_count": schema.Int64Attribute{Computed: true, Description: "The number of evaluated entities the check's `filter_sql` excluded."},
+		},
+	}
+}
+
+func (d *CheckPreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dxapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dxapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+// ValidateConfig lints the check's queries, like the checks of dx_scorecard.
+func (d *CheckPreviewDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var check types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("check"), &check)...)
+	if resp.Diagnostics.HasError() || check.IsNull() || check.IsUnknown() {
+		return
+	}
+
+	var model CheckModel
+	if diags := check.As(ctx, &model, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return
+	}
+	validateCheckSQL(model, path.Root("check"), &resp.Diagnostics)
+}
+
+func (d *CheckPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading scorecard check preview data source")
+
+	var config CheckPreviewDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sampleSize := int64(defaultSampleSize)
+	if !config.SampleSize.IsNull() {
+		sampleSize = config.SampleSize.ValueInt64()
+	}
+	payload := map[string]interface{}{
+		"sql":            config.Check.Sql.ValueString(),
+		"output_enabled": config.Check.OutputEnabled.ValueBool(),
+		"limit":          sampleSize,
+	}
+	if !config.Check.FilterSql.IsNull() {
+		payload["filter_sql"] = config.Check.FilterSql.ValueString()
+	}
+	if !config.Check.OutputType.IsNull() {
+		payload["output_type"] = config.Check.OutputType.ValueString()
+	}
+	switch {
+	case !config.ScorecardId.IsNull():
+		payload["scorecard_id"] = config.ScorecardId.ValueString()
+	case !config.EntityFilterSql.IsNull():
+		payload["entity_filter_sql"] = config.EntityFilterSql.ValueString()
+	default:
+		identifiers := make([]string, 0, len(config.EntityFilterTypeIdentifiers))
+		for _, identifier := range config.EntityFilterTypeIdentifiers {
+			identifiers = append(identifiers, identifier.ValueString())
+		}
+		payload["entity_filter_type_identifiers"] = identifiers
+	}
+
+	apiResp, err := d.client.EvaluateScorecardCheck(ctx, payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error evaluating scorecard check", err.Error())
+		return
+	}
+
+	state := config
+	state.SampleSize = types.Int64Value(sampleSize)
+	state.Results = []CheckPreviewResultModel{}
+	counts := map[string]int64{}
+	for _, result := range apiResp.Results {
+		status := "EXCLUDED"
+		if result.Status != nil {
+			status = *result.Status
+		}
+		counts[status]++
+
+		state.Results = append(state.Results, CheckPreviewResultModel{
+			EntityIdentifier: types.StringValue(result.EntityIdentifier),
+			EntityName:       types.StringPointerValue(result.EntityName),
+			Status:           types.StringPointerValue(result.Status),
+			Output:           outputToString(result.Output),
+			Message:          types.StringPointerValue(result.Message),
+		})
+	}
+	state.PassCount = types.Int64Value(counts["PASS"])
+	state.WarnCount = types.Int64Value(counts["WARN"])
+	state.FailCount = types.Int64Value(counts["FAIL"])
+	state.ExcludedCount = types.Int64Value(counts["EXCLUDED"])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// outputToString converts a check's output, which is a JSON value of the check's output type,
+// to a string. Strings are unquoted, and other values keep their JSON form.
+func outputToString(output json.RawMessage) types.String {
+	if len(output) == 0 || string(output) == "null" {
+		return types.StringNull()
+	}
+	var s string
+	if err := json.Unmarshal(output, &s); err == nil {
+		return types.StringValue(s)
+	}
+	return types.StringValue(string(output))
+}
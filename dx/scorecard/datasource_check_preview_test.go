@@ -0,0 +1,93 @@
+package scorecard_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-dx/internal/acctest"
+)
+
+func TestAccDxScorecardCheckPreviewDataSource(t *testing.T) {
+	entityType := fmt.Sprintf("tfpreview%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The check's queries are linted before they are evaluated
+			{
+				Config:      testAccCheckPreviewDataSourceConfig(entityType, "select 'PASS' as result", ""),
+				ExpectError: regexp.MustCompile(`must return a column named "status"`),
+				PlanOnly:    true,
+			},
+			// Evaluate a check against both entities of the type
+			{
+				Config: testAccCheckPreviewDataSourceConfig(entityType, "select 'FAIL' as status, 42 as output", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dx_scorecard_check_preview.test", "results.#", "2"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_check_preview.test", "results.0.entity_identifier", entityType+"-a"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_check_preview.test", "results.0.status", "FAIL"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_check_preview.test", "results.0.output", "42"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_check_preview.test", "fail_count", "2"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_check_preview.test", "pass_count", "0"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_check_preview.test", "excluded_count", "0"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_check_preview.test", "sample_size", "100"),
+				),
+			},
+			// The check's filter excludes one of the entities
+			{
+				Config: testAccCheckPreviewDataSourceConfig(entityType, "select 'PASS' as status, 42 as output", fmt.Sprintf("select identifier from entities where identifier = '%s-b'", entityType)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dx_scorecard_check_preview.test", "pass_count", "1"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_check_preview.test", "excluded_count", "1"),
+					resource.TestCheckNoResourceAttr("data.dx_scorecard_check_preview.test", "results.0.status"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_check_preview.test", "results.0.message", "Excluded by the check's filter"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_check_preview.test", "results.1.status", "PASS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPreviewDataSourceConfig(entityType, sql, filterSql string) string {
+	filter := ""
+	if filterSql != "" {
+		filter = fmt.Sprintf("filter_sql = %q", filterSql)
+	}
+	return fmt.Sprintf(`
+provider "dx" {}
+
+resource "dx_entity_type" "test" {
+  identifier = "%[1]s"
+  name       = "Preview Test"
+}
+
+resource "dx_entity" "a" {
+  identifier = "%[1]s-a"
+  type       = dx_entity_type.test.identifier
+  name       = "Entity A"
+}
+
+resource "dx_entity" "b" {
+  identifier = "%[1]s-b"
+  type       = dx_entity_type.test.identifier
+  name       = "Entity B"
+}
+
+data "dx_scorecard_check_preview" "test" {
+  entity_filter_type_identifiers = [dx_entity_type.test.identifier]
+
+  check = {
+    sql            = %[2]q
+    output_enabled = true
+    output_type    = "number"
+    %[3]s
+  }
+
+  depends_on = [dx_entity.a, dx_entity.b]
+}
+`, entityType, sql, filter)
+}
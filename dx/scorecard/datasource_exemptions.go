@@ -0,0 +1,126 @@
+package scorecard
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &ExemptionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ExemptionsDataSource{}
+)
+
+func NewExemptionsDataSource() datasource.DataSource {
+	return &ExemptionsDataSource{}
+}
+
+// ExemptionsDataSource lists the active scorecard check exemptions, including those granted
+// outside Terraform.
+type ExemptionsDataSource struct {
+	client *dxapi.Client
+}
+
+type ExemptionsDataSourceModel struct {
+	ScorecardId      types.String     `tfsdk:"scorecard_id"`
+	CheckId          types.String     `tfsdk:"check_id"`
+	EntityIdentifier types.String     `tfsdk:"entity_identifier"`
+	Exemptions       []ExemptionModel `tfsdk:"exemptions"`
+}
+
+func (d *ExemptionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scorecard_check_exemptions"
+}
+
+func (d *ExemptionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the active scorecard check exemptions, i.e. those that have not expired, including exemptions granted in the DX UI. Every filter that is set must match.",
+		Attributes: map[string]schema.Attribute{
+			"scorecard_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list exemptions from checks of this scorecard.",
+			},
+			"check_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list exemptions from this check.",
+			},
+			"entity_identifier": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list exemptions of this entity.",
+			},
+			"exemptions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The active exemptions, oldest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                schema.StringAttribute{Computed: true, Description: "The ID of the exemption."},
+						"scorecard_id":      schema.StringAttribute{Computed: true, Description: "The ID of the scorecard."},
+						"check_id":          schema.StringAttribute{Computed: true, Description: "The ID of the check the entity is exempted from."},
+						"entity_identifier": schema.StringAttribute{Computed: true, Description: "The identifier of the exempted entity."},
+						"reason":            schema.StringAttribute{Computed: true, Description: "Why the entity is exempted from the check."},
+						"expires_at":        schema.StringAttribute{Computed: true, Description: "When the exemption expires, or null if it never does."},
+						"created_at":        schema.StringAttribute{Computed: true, Description: "Timestamp when the exemption was created."},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ExemptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dxapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dxapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (d *ExemptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading scorecard check exemptions data source")
+
+	var config ExemptionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &dxapi.ListExemptionsOptions{
+		ScorecardId:      config.ScorecardId.ValueString(),
+		CheckId:          config.CheckId.ValueString(),
+		EntityIdentifier: config.EntityIdentifier.ValueString(),
+	}
+
+	state := config
+	state.Exemptions = []ExemptionModel{}
+	for apiExemption, err := range d.client.IterateExemptions(ctx, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing scorecard check exemptions", err.Error())
+			return
+		}
+
+		var exemption ExemptionModel
+		exemptionToModel(&apiExemption, &exemption)
+		state.Exemptions = append(state.Exemptions, exemption)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,234 @@
+package scorecard
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &ScorecardResultsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ScorecardResultsDataSource{}
+)
+
+func NewScorecardResultsDataSource() datasource.DataSource {
+	return &ScorecardResultsDataSource{}
+}
+
+// ScorecardResultsDataSource reads the current outcome of a scorecard for the entities it
+// assesses, e.g. to gate a deploy on a service reaching a level.
+type ScorecardResultsDataSource struct {
+	client *dxapi.Client
+}
+
+type ScorecardResultsDataSourceModel struct {
+	ScorecardId      types.String           `tfsdk:"scorecard_id"`
+	EntityIdentifier types.String           `tfsdk:"entity_identifier"`
+	Limit            types.Int64            `tfsdk:"limit"`
+	Results          []ScorecardResultModel `tfsdk:"results"`
+}
+
+type ScorecardResultModel struct {
+	EntityIdentifier types.String `tfsdk:"entity_identifier"`
+	EntityName       types.String `tfsdk:"entity_name"`
+	EntityType       types.String `tfsdk:"entity_type"`
+
+	// Levels scorecards
+	LevelId   types.String `tfsdk:"level_id"`
+	LevelKey  types.String `tfsdk:"level_key"`
+	LevelName types.String `tfsdk:"level_name"`
+	LevelRank types.Int32  `tfsdk:"level_rank"`
+
+	// Points scorecards
+	Points         types.Int32 `tfsdk:"points"`
+	PointsPossible types.Int32 `tfsdk:"points_possible"`
+
+	PassingCheckIds []types.String     `tfsdk:"passing_check_ids"`
+	WarningCheckIds []types.String     `tfsdk:"warning_check_ids"`
+	FailingCheckIds []types.String     `tfsdk:"failing_check_ids"`
+	CheckResults    []CheckResultModel `tfsdk:"check_results"`
+}
+
+type CheckResultModel struct {
+	CheckId   types.String `tfsdk:"check_id"`
+	CheckName types.String `tfsdk:"check_name"`
+	Status    types.String `tfsdk:"status"`
+	Output    types.String `tfsdk:"output"`
+}
+
+func (d *ScorecardResultsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scorecard_results"
+}
+
+func (d *ScorecardResultsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	checkIdsAttribute := func(description string) schema.ListAttribute {
+		return schema.ListAttribute{Computed: true, ElementType: types.StringType, Description: description}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reads the current results of a scorecard: the level or points each assessed entity has reached, and the outcome of every check. Use it to show scorecard outcomes or to gate deploys on a service reaching a level.",
+		Attributes: map[string]schema.Attribute{
+			"scorecard_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the scorecard.",
+			},
+			"entity_identifier": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the result of this entity. `results` is empty when the scorecard does not assess the entity.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of results to return. Listing stops as soon as this many results have been read. All results are returned if not set.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The result for each entity the scorecard assesses, ordered by entity identifier.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"entity_identifier": schema.StringAttribute{Computed: true, Description: "The identifier of the entity."},
+						"entity_name":       schema.StringAttribute{Computed: true, Description: "The name of the entity."},
+						"entity_type":       schema.StringAttribute{Computed: true, Description: "The entity type identifier of the entity."},
+						"level_id":          schema.StringAttribute{Computed: true, Description: "The ID of the highest level the entity reached, or null when it reached none (levels scorecards only)."},
+						"level_key":         schema.StringAttribute{Computed: true, Description: "The snake cased name of the level, which is its key in the `levels` of the `dx_scorecard` data source."},
+						"level_name":        schema.StringAttribute{Computed: true, Description: "The name of the level."},
+						"level_rank":        schema.Int32Attribute{Computed: true, Description: "The rank of the level, e.g. to compare it to the rank of the level a deploy requires."},
+						"points":            schema.Int32Attribute{Computed: true, Description: "The points the entity earned (points scorecards only)."},
+						"points_possible":   schema.Int32Attribute{Computed: true, Description: "The points the entity could earn from the checks that apply to it (points scorecards only)."},
+						"passing_check_ids": checkIdsAttribute("The IDs of the checks with status PASS."),
+						"warning_check_ids": checkIdsAttribute("The IDs of the checks with status WARN."),
+						"failing_check_ids": checkIdsAttribute("The IDs of the checks with status FAIL."),
+						"check_results": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The outcome of every check of the scorecard for the entity.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"check_id":   schema.StringAttribute{Computed: true, Description: "The ID of the check."},
+									"check_name": schema.StringAttribute{Computed: true, Description: "The name of the check."},
+									"status":     schema.StringAttribute{Computed: true, Description: "PASS, WARN or FAIL, or null when the check's `filter_sql` excludes the entity."},
+									"output":     schema.StringAttribute{Computed: true, Description: "The output of the check, for checks with `output_enabled`. Numbers are returned as strings."},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ScorecardResultsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dxapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dxapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (d *ScorecardResultsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading scorecard results data source")
+
+	var config ScorecardResultsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scorecardId := config.ScorecardId.ValueString()
+	opts := &dxapi.ListScorecardResultsOptions{}
+	if !config.EntityIdentifier.IsNull() {
+		entityIdentifier := config.EntityIdentifier.ValueString()
+		opts.EntityIdentifier = &entityIdentifier
+	}
+	if !config.Limit.IsNull() {
+		opts.MaxResults = int(config.Limit.ValueInt64())
+	}
+
+	state := config
+	state.Results = []ScorecardResultModel{}
+	for apiResult, err := range d.client.IterateScorecardResults(ctx, scorecardId, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error listing scorecard results",
+				fmt.Sprintf("Could not list the results of scorecard %s: %s", scorecardId, err.Error()),
+			)
+			return
+		}
+		state.Results = append(state.Results, scorecardResultToModel(ctx, &apiResult))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func scorecardResultToModel(ctx context.Context, apiResult *dxapi.APIScorecardResult) ScorecardResultModel {
+	result := ScorecardResultModel{
+		EntityIdentifier: types.StringValue(apiResult.EntityIdentifier),
+		EntityName:       types.StringPointerValue(apiResult.EntityName),
+		EntityType:       types.StringValue(apiResult.EntityType),
+		LevelId:          types.StringNull(),
+		LevelKey:         types.StringNull(),
+		LevelName:        types.StringNull(),
+		LevelRank:        types.Int32Null(),
+		Points:           types.Int32PointerValue(apiResult.Points),
+		PointsPossible:   types.Int32PointerValue(apiResult.PointsPossible),
+		PassingCheckIds:  []types.String{},
+		WarningCheckIds:  []types.String{},
+		FailingCheckIds:  []types.String{},
+		CheckResults:     []CheckResultModel{},
+	}
+
+	if level := apiResult.Level; level != nil {
+		result.LevelId = types.StringPointerValue(level.Id)
+		result.LevelName = types.StringPointerValue(level.Name)
+		result.LevelRank = types.Int32PointerValue(level.Rank)
+		if level.Name != nil {
+			result.LevelKey = types.StringValue(nameToKey(ctx, *level.Name))
+		}
+	}
+
+	for _, check := range apiResult.CheckResults {
+		checkId := types.StringValue(check.CheckId)
+		if check.Status != nil {
+			switch *check.Status {
+			case "PASS":
+				result.PassingCheckIds = append(result.PassingCheckIds, checkId)
+			case "WARN":
+				result.WarningCheckIds = append(result.WarningCheckIds, checkId)
+			case "FAIL":
+				result.FailingCheckIds = append(result.FailingCheckIds, checkId)
+			}
+		}
+
+		result.CheckResults = append(result.CheckResults, CheckResultModel{
+			CheckId:   checkId,
+			CheckName: types.StringPointerValue(check.CheckName),
+			Status:    types.StringPointerValue(check.Status),
+			Output:    outputToString(check.Output),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,171 @@
+package scorecard_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-dx/internal/acctest"
+)
+
+func TestAccDxScorecardResultsDataSource(t *testing.T) {
+	entityType := fmt.Sprintf("tfresults%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The results can be read as soon as the scorecard exists. DX evaluates a new scorecard
+			// in the background, so wait for both entities to be assessed before reading them again.
+			{
+				Config: testAccScorecardResultsDataSourceConfig(entityType),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("dx_scorecard.test", "id", "data.dx_scorecard_results.all", "scorecard_id"),
+					resource.TestCheckResourceAttrSet("data.dx_scorecard_results.all", "results.#"),
+					waitForScorecardResults("dx_scorecard.test", 2, 10*time.Minute),
+				),
+			},
+			// Entity a passes both levels, while b fails the silver check, which only applies to b
+			{
+				Config: testAccScorecardResultsDataSourceConfig(entityType),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dx_scorecard_results.all", "results.#", "2"),
+
+					resource.TestCheckResourceAttr("data.dx_scorecard_results.all", "results.0.entity_identifier", entityType+"-a"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_results.all", "results.0.entity_type", entityType),
+					resource.TestCheckResourceAttr("data.dx_scorecard_results.all", "results.0.level_key", "silver"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_results.all", "results.0.level_rank", "2"),
+					resource.TestCheckResourceAttrPair("dx_scorecard.test", "levels.silver.id", "data.dx_scorecard_results.all", "results.0.level_id"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_results.all", "results.0.passing_check_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_results.all", "results.0.failing_check_ids.#", "0"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_results.all", "results.0.check_results.#", "2"),
+					resource.TestCheckNoResourceAttr("data.dx_scorecard_results.all", "results.0.points"),
+
+					resource.TestCheckResourceAttr("data.dx_scorecard_results.all", "results.1.level_name", "Bronze"),
+					resource.TestCheckResourceAttrPair("dx_scorecard.test", "checks.silver_check.id", "data.dx_scorecard_results.all", "results.1.failing_check_ids.0"),
+
+					resource.TestCheckResourceAttr("data.dx_scorecard_results.one", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_results.one", "results.0.entity_identifier", entityType+"-b"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_results.one", "results.0.entity_name", "Entity B"),
+
+					resource.TestCheckResourceAttr("data.dx_scorecard_results.limited", "results.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// waitForScorecardResults polls the DX API until the scorecard behind resourceName has results
+// for count entities, or fails after timeout.
+func waitForScorecardResults(resourceName string, count int, timeout time.Duration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		client := acctest.Client()
+		deadline := time.Now().Add(timeout)
+		for {
+			results, err := client.ListScorecardResults(context.Background(), rs.Primary.ID, nil)
+			if err != nil {
+				return fmt.Errorf("listing results of %s: %w", resourceName, err)
+			}
+			if len(results) >= count {
+				return nil
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("%s has results for %d entities after %s, expected %d", resourceName, len(results), timeout, count)
+			}
+			time.Sleep(10 * time.Second)
+		}
+	}
+}
+
+func testAccScorecardResultsDataSourceConfig(entityType string) string {
+	return fmt.Sprintf(`
+provider "dx" {}
+
+resource "dx_entity_type" "test" {
+  identifier = "%[1]s"
+  name       = "Results Test"
+}
+
+resource "dx_entity" "a" {
+  identifier = "%[1]s-a"
+  type       = dx_entity_type.test.identifier
+  name       = "Entity A"
+}
+
+resource "dx_entity" "b" {
+  identifier = "%[1]s-b"
+  type       = dx_entity_type.test.identifier
+  name       = "Entity B"
+}
+
+resource "dx_scorecard" "test" {
+  name                           = "Results %[1]s"
+  type                           = "LEVEL"
+  entity_filter_type             = "entity_types"
+  entity_filter_type_identifiers = [dx_entity_type.test.identifier]
+  evaluation_frequency_hours     = 2
+  empty_level_label              = "Incomplete"
+  empty_level_color              = "#cccccc"
+
+  levels = {
+    bronze = {
+      name  = "Bronze"
+      color = "#FB923C"
+      rank  = 1
+    },
+    silver = {
+      name  = "Silver"
+      color = "#9CA3AF"
+      rank  = 2
+    },
+  }
+
+  checks = {
+    bronze_check = {
+      name                = "Bronze Check"
+      scorecard_level_key = "bronze"
+      ordering            = 0
+      sql                 = "select 'PASS' as status"
+      output_enabled      = false
+      published           = true
+    }
+    silver_check = {
+      name                = "Silver Check"
+      scorecard_level_key = "silver"
+      ordering            = 0
+      sql                 = "select 'FAIL' as status"
+      filter_sql          = "select identifier from entities where identifier = '%[1]s-b'"
+      output_enabled      = false
+      published           = true
+    }
+  }
+}
+
+data "dx_scorecard_results" "all" {
+  scorecard_id = dx_scorecard.test.id
+
+  depends_on = [dx_entity.a, dx_entity.b]
+}
+
+data "dx_scorecard_results" "one" {
+  scorecard_id      = dx_scorecard.test.id
+  entity_identifier = dx_entity.b.identifier
+}
+
+data "dx_scorecard_results" "limited" {
+  scorecard_id = dx_scorecard.test.id
+  limit        = 1
+
+  depends_on = [dx_entity.a, dx_entity.b]
+}
+`, entityType)
+}
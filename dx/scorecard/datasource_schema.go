@@ -0,0 +1,134 @@
+package scorecard
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The data source schemas mirror ScorecardSchema with every attribute computed, so data sources
+// can reuse ScorecardModel and responseBodyToModel.
+
+func dataSourceLevelSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id":    schema.StringAttribute{Computed: true},
+		"name":  schema.StringAttribute{Computed: true},
+		"color": schema.StringAttribute{Computed: true},
+		"rank":  schema.Int32Attribute{Computed: true},
+	}
+}
+
+func dataSourceCheckGroupSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id":       schema.StringAttribute{Computed: true},
+		"name":     schema.StringAttribute{Computed: true},
+		"ordering": schema.Int32Attribute{Computed: true},
+	}
+}
+
+func dataSourceCheckSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id":                 schema.StringAttribute{Computed: true},
+		"name":               schema.StringAttribute{Computed: true},
+		"description":        schema.StringAttribute{Computed: true},
+		"ordering":           schema.Int32Attribute{Computed: true},
+		"sql":                schema.StringAttribute{Computed: true},
+		"filter_sql":         schema.StringAttribute{Computed: true},
+		"filter_message":     schema.StringAttribute{Computed: true},
+		"output_enabled":     schema.BoolAttribute{Computed: true},
+		"output_type":        schema.StringAttribute{Computed: true},
+		"output_aggregation": schema.StringAttribute{Computed: true},
+		"output_custom_options": schema.SingleNestedAttribute{
+			Computed: true,
+			Attributes: map[string]schema.Attribute{
+				"unit":     schema.StringAttribute{Computed: true, Description: "The unit of the output, e.g. `widget`"},
+				"decimals": schema.Int32Attribute{Computed: true, Description: "The number of decimals to display, or `null` for \"auto\"."},
+			},
+		},
+		"estimated_dev_days":        schema.Float32Attribute{Computed: true},
+		"external_url":              schema.StringAttribute{Computed: true},
+		"published":                 schema.BoolAttribute{Computed: true},
+		"scorecard_level_key":       schema.StringAttribute{Computed: true, Description: "The key of the level that this check belongs to (levels scorecards only)."},
+		"scorecard_check_group_key": schema.StringAttribute{Computed: true, Description: "The key of the check group that this check belongs to (points scorecards only)."},
+		"points":                    schema.Int32Attribute{Computed: true},
+	}
+}
+
+func dataSourceScorecardSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Unique identifier for the scorecard.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the scorecard.",
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The type of scorecard. [LEVEL|POINTS]",
+		},
+		"entity_filter_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The filtering strategy for deciding what entities this scorecard should assess. [entity_types|sql]",
+		},
+		"evaluation_frequency_hours": schema.Int32Attribute{
+			Computed:    true,
+			Description: "How often the scorecard is evaluated (in hours). [2|4|8|24]",
+		},
+		"tags": schema.SetNestedAttribute{
+			Computed:    true,
+			Description: "Tags applied to the scorecard.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"value": schema.StringAttribute{Computed: true, Description: "The value of the tag."},
+				},
+			},
+		},
+		"empty_level_label": schema.StringAttribute{
+			Computed:    true,
+			Description: "The label to display when an entity has not achieved any levels in the scorecard (levels scorecards only).",
+		},
+		"empty_level_color": schema.StringAttribute{
+			Computed:    true,
+			Description: "The color hex code to display when an entity has not achieved any levels in the scorecard (levels scorecards only).",
+		},
+		"levels": schema.MapNestedAttribute{
+			Computed:    true,
+			Description: "The levels that can be achieved in this scorecard, keyed by the snake cased level name (levels scorecards only).",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: dataSourceLevelSchema(),
+			},
+		},
+		"check_groups": schema.MapNestedAttribute{
+			Computed:    true,
+			Description: "Groups of checks, keyed by the snake cased group name (points scorecards only).",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: dataSourceCheckGroupSchema(),
+			},
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: "Description of the scorecard.",
+		},
+		"published": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the scorecard is published.",
+		},
+		"entity_filter_type_identifiers": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "List of entity type identifiers that the scorecard runs against.",
+		},
+		"entity_filter_sql": schema.StringAttribute{
+			Computed:    true,
+			Description: "Custom SQL used to filter entities that the scorecard runs against.",
+		},
+		"checks": schema.MapNestedAttribute{
+			Computed:    true,
+			Description: "The checks applied to entities in the scorecard, keyed by the snake cased check name.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: dataSourceCheckSchema(),
+			},
+		},
+	}
+}
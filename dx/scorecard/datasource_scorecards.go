@@ -0,0 +1,153 @@
+package scorecard
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &ScorecardsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ScorecardsDataSource{}
+)
+
+func NewScorecardsDataSource() datasource.DataSource {
+	return &ScorecardsDataSource{}
+}
+
+type ScorecardsDataSource struct {
+	client *dxapi.Client
+}
+
+type ScorecardsDataSourceModel struct {
+	Type             types.String     `tfsdk:"type"`
+	Tag              types.String     `tfsdk:"tag"`
+	Published        types.Bool       `tfsdk:"published"`
+	EntityFilterType types.String     `tfsdk:"entity_filter_type"`
+	Scorecards       []ScorecardModel `tfsdk:"scorecards"`
+}
+
+func (d *ScorecardsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scorecards"
+}
+
+func (d *ScorecardsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists DX scorecards, optionally filtered by type, tag, published status or entity filter type.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return scorecards of this type. [LEVEL|POINTS]",
+				Validators: []validator.String{
+					stringvalidator.OneOf("LEVEL", "POINTS"),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return scorecards with this tag.",
+			},
+			"published": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return published (`true`) or unpublished (`false`) scorecards.",
+			},
+			"entity_filter_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return scorecards using this entity filtering strategy. [entity_types|sql]",
+				Validators: []validator.String{
+					stringvalidator.OneOf("entity_types", "sql"),
+				},
+			},
+			"scorecards": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The scorecards matching every given filter.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: dataSourceScorecardSchema(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ScorecardsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dxapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dxapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (d *ScorecardsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading scorecards data source")
+
+	var config ScorecardsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := ScorecardsDataSourceModel{
+		Type:             config.Type,
+		Tag:              config.Tag,
+		Published:        config.Published,
+		EntityFilterType: config.EntityFilterType,
+		Scorecards:       []ScorecardModel{},
+	}
+
+	for apiScorecard, err := range d.client.IterateScorecards(ctx, nil) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing scorecards", err.Error())
+			return
+		}
+		if !config.matches(&apiScorecard) {
+			continue
+		}
+		state.Scorecards = append(state.Scorecards, apiScorecardToDataSourceModel(ctx, &apiScorecard))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// matches reports whether a scorecard passes every filter set in the configuration.
+func (m *ScorecardsDataSourceModel) matches(sc *dxapi.APIScorecard) bool {
+	if !m.Type.IsNull() && sc.Type != m.Type.ValueString() {
+		return false
+	}
+	if !m.Published.IsNull() && sc.Published != m.Published.ValueBool() {
+		return false
+	}
+	if !m.EntityFilterType.IsNull() && sc.EntityFilterType != m.EntityFilterType.ValueString() {
+		return false
+	}
+	if !m.Tag.IsNull() {
+		for _, tag := range sc.Tags {
+			if tag.Value == m.Tag.ValueString() {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
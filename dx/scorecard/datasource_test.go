@@ -0,0 +1,100 @@
+package scorecard_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-dx/internal/acctest"
+)
+
+func TestAccDxScorecardDataSource(t *testing.T) {
+	scorecardName := fmt.Sprintf("Terraform Data Source Scorecard %d", acctest.RandInt())
+	tag := fmt.Sprintf("tf-ds-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a scorecard and then look it up by ID, by name and in a filtered list
+			{
+				Config: testAccScorecardDataSourceConfig(scorecardName, tag),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("dx_scorecard.test", "id", "data.dx_scorecard.by_id", "id"),
+					resource.TestCheckResourceAttr("data.dx_scorecard.by_id", "name", scorecardName),
+					resource.TestCheckResourceAttr("data.dx_scorecard.by_id", "type", "POINTS"),
+					resource.TestCheckResourceAttr("data.dx_scorecard.by_id", "published", "true"),
+					resource.TestCheckResourceAttrPair("dx_scorecard.test", "check_groups.basics.id", "data.dx_scorecard.by_id", "check_groups.basics.id"),
+					resource.TestCheckResourceAttrPair("dx_scorecard.test", "checks.passing_check.id", "data.dx_scorecard.by_id", "checks.passing_check.id"),
+					resource.TestCheckResourceAttr("data.dx_scorecard.by_id", "checks.passing_check.scorecard_check_group_key", "basics"),
+
+					resource.TestCheckResourceAttrPair("dx_scorecard.test", "id", "data.dx_scorecard.by_name", "id"),
+
+					resource.TestCheckResourceAttr("data.dx_scorecards.tagged", "scorecards.#", "1"),
+					resource.TestCheckResourceAttrPair("dx_scorecard.test", "id", "data.dx_scorecards.tagged", "scorecards.0.id"),
+					resource.TestCheckResourceAttr("data.dx_scorecards.tagged_levels", "scorecards.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScorecardDataSourceConfig(name, tag string) string {
+	return fmt.Sprintf(`
+provider "dx" {}
+
+resource "dx_scorecard" "test" {
+  name                           = "%s"
+  type                           = "POINTS"
+  entity_filter_type             = "entity_types"
+  entity_filter_type_identifiers = ["service"]
+  evaluation_frequency_hours     = 2
+  published                      = true
+
+  tags = [
+    { value = "%s" },
+  ]
+
+  check_groups = {
+    basics = {
+      name     = "Basics"
+      ordering = 0
+    }
+  }
+
+  checks = {
+    passing_check = {
+      name                      = "Passing Check"
+      scorecard_check_group_key = "basics"
+      ordering                  = 0
+      points                    = 1
+      sql                       = "select 'PASS' as status"
+      output_enabled            = false
+      published                 = true
+    }
+  }
+}
+
+data "dx_scorecard" "by_id" {
+  id = dx_scorecard.test.id
+}
+
+data "dx_scorecard" "by_name" {
+  name = dx_scorecard.test.name
+}
+
+data "dx_scorecards" "tagged" {
+  tag = "%s"
+
+  depends_on = [dx_scorecard.test]
+}
+
+data "dx_scorecards" "tagged_levels" {
+  tag  = "%s"
+  type = "LEVEL"
+
+  depends_on = [dx_scorecard.test]
+}
+`, name, tag, tag, tag)
+}
@@ -30,6 +30,21 @@ type ScorecardModel struct {
 	Checks                      map[string]CheckModel `tfsdk:"checks"`
 }
 
+// ScorecardResourceModel is the dx_scorecard resource data model. Attributes that only affect how
+// the resource manages the scorecard are not shared with the data sources.
+type ScorecardResourceModel struct {
+	ScorecardModel
+
+	IgnoreExternalChecks types.Bool `tfsdk:"ignore_external_checks"`
+}
+
+// ScorecardCheckModel describes the dx_scorecard_check resource data model.
+type ScorecardCheckModel struct {
+	ScorecardId types.String `tfsdk:"scorecard_id"`
+
+	CheckModel
+}
+
 type TagModel struct {
 	Value types.String `tfsdk:"value"`
 }
@@ -77,3 +92,15 @@ type OutputCustomOptionsModel struct {
 	Unit     types.String `tfsdk:"unit"`
 	Decimals types.Int32  `tfsdk:"decimals"`
 }
+
+// ExemptionModel is the state of a dx_scorecard_check_exemption resource, and of each exemption
+// listed by the dx_scorecard_check_exemptions data source.
+type ExemptionModel struct {
+	Id               types.String `tfsdk:"id"`
+	ScorecardId      types.String `tfsdk:"scorecard_id"`
+	CheckId          types.String `tfsdk:"check_id"`
+	EntityIdentifier types.String `tfsdk:"entity_identifier"`
+	Reason           types.String `tfsdk:"reason"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+}
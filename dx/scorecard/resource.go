@@ -7,6 +7,7 @@ import (
 
 	"terraform-provider-dx/dx"
 	"terraform-provider-dx/dx/dxapi"
+	"terraform-provider-dx/dx/sqllint"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,8 +19,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ScorecardResource{}
-	_ resource.ResourceWithImportState = &ScorecardResource{}
+	_ resource.Resource                   = &ScorecardResource{}
+	_ resource.ResourceWithImportState    = &ScorecardResource{}
+	_ resource.ResourceWithValidateConfig = &ScorecardResource{}
 )
 
 func NewScorecardResource() resource.Resource {
@@ -63,7 +65,7 @@ func (r *ScorecardResource) Create(ctx context.Context, req resource.CreateReque
 	tflog.Debug(ctx, "Creating scorecard resource!")
 
 	// Retrieve values from plan
-	var plan ScorecardModel
+	var plan ScorecardResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -73,12 +75,12 @@ func (r *ScorecardResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	tflog.Debug(ctx, "Got plan, validating...")
-	ValidateModel(plan, &resp.Diagnostics)
+	ValidateModel(plan.ScorecardModel, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	payload, err := modelToRequestBody(ctx, plan, false)
+	payload, err := modelToRequestBody(ctx, plan.ScorecardModel, false)
 	if err != nil {
 		resp.Diagnostics.AddError("Error converting plan to request body", err.Error())
 		return
@@ -87,13 +89,13 @@ func (r *ScorecardResource) Create(ctx context.Context, req resource.CreateReque
 	// Create Scorecard (apiResp is a struct of type APIResponse)
 	apiResp, err := r.client.CreateScorecard(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating scorecard", err.Error())
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error creating scorecard", err)
 		return
 	}
 
 	// Shallow copy of plan to preserve values
-	oldPlan := plan
-	responseBodyToModel(ctx, apiResp, &plan, &oldPlan)
+	oldPlan := plan.ScorecardModel
+	responseBodyToModel(ctx, apiResp, &plan.ScorecardModel, &oldPlan)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -102,7 +104,7 @@ func (r *ScorecardResource) Create(ctx context.Context, req resource.CreateReque
 func (r *ScorecardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	tflog.Info(ctx, "Reading scorecard resource")
 
-	var state ScorecardModel
+	var state ScorecardResourceModel
 
 	// Load existing state
 	diags := req.State.Get(ctx, &state)
@@ -111,8 +113,6 @@ func (r *ScorecardResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	tflog.Info(ctx, fmt.Sprintf("Prior state, before reading from API: %v", state))
-
 	// Extract ID
 	id := state.Id.ValueString()
 	if id == "" {
@@ -123,6 +123,11 @@ func (r *ScorecardResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Call the API to get the latest scorecard data
 	apiResp, err := r.client.GetScorecard(ctx, id)
 	if err != nil {
+		if dxapi.IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Scorecard %s not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading scorecard",
 			fmt.Sprintf("Could not read scorecard ID %s: %s", id, err.Error()),
@@ -130,50 +135,85 @@ func (r *ScorecardResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// Checks this resource did not create are managed elsewhere, e.g. by dx_scorecard_check
+	if state.IgnoreExternalChecks.ValueBool() {
+		managed := checkIDs(state.Checks)
+		apiResp = withoutChecks(apiResp, func(chk *dxapi.APICheck) bool { return !managed[*chk.Id] })
+	}
+
 	// Map API response to Terraform state model
 	// Shallow copy of plan to preserve values
-	oldState := state
-	responseBodyToModel(ctx, apiResp, &state, &oldState)
+	oldState := state.ScorecardModel
+	responseBodyToModel(ctx, apiResp, &state.ScorecardModel, &oldState)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *ScorecardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan ScorecardModel
+	var plan, prior ScorecardResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...) // Get the desired state
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	tflog.Debug(ctx, "Got plan, validating...")
-	ValidateModel(plan, &resp.Diagnostics)
+	ValidateModel(plan.ScorecardModel, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	payload, err := modelToRequestBody(ctx, plan, true)
+	payload, err := modelToRequestBody(ctx, plan.ScorecardModel, true)
 	if err != nil {
 		resp.Diagnostics.AddError("Error converting plan to request body", err.Error())
 		return
 	}
 
+	// The update replaces every check of the scorecard, so checks managed elsewhere are sent back
+	// unchanged
+	external := map[string]bool{}
+	if plan.IgnoreExternalChecks.ValueBool() {
+		current, err := r.client.GetScorecard(ctx, plan.Id.ValueString())
+		if err != nil {
+			dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error reading scorecard", err)
+			return
+		}
+
+		managed := checkIDs(prior.Checks)
+		checks, _ := payload["checks"].([]map[string]interface{})
+		for _, chk := range current.Scorecard.Checks {
+			if chk.Id == nil || managed[*chk.Id] {
+				continue
+			}
+			checkPayload, err := externalCheckToRequestBody(chk, plan.ScorecardModel)
+			if err != nil {
+				resp.Diagnostics.AddError("Error converting plan to request body", err.Error())
+				return
+			}
+			external[*chk.Id] = true
+			checks = append(checks, checkPayload)
+		}
+		payload["checks"] = checks
+	}
+
 	apiResp, err := r.client.UpdateScorecard(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating scorecard", err.Error())
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error updating scorecard", err)
 		return
 	}
+	apiResp = withoutChecks(apiResp, func(chk *dxapi.APICheck) bool { return external[*chk.Id] })
 
 	// Map API response to Terraform state model
-	oldPlan := plan
-	responseBodyToModel(ctx, apiResp, &plan, &oldPlan)
+	oldPlan := plan.ScorecardModel
+	responseBodyToModel(ctx, apiResp, &plan.ScorecardModel, &oldPlan)
 
 	diags := resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *ScorecardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state ScorecardModel
+	var state ScorecardResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...) // Get the current state
 	if resp.Diagnostics.HasError() {
 		return
@@ -187,7 +227,7 @@ func (r *ScorecardResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	success, err := r.client.DeleteScorecard(ctx, id)
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting scorecard", err.Error())
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error deleting scorecard", err)
 		return
 	}
 	if !success {
@@ -203,6 +243,27 @@ func (r *ScorecardResource) ImportState(ctx context.Context, req resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// ValidateConfig lints the scorecard's SQL queries, so mistakes are reported by `terraform plan`
+// rather than when DX evaluates the scorecard hours later.
+func (r *ScorecardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var entityFilterSql types.String
+	var checks types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("entity_filter_sql"), &entityFilterSql)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("checks"), &checks)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Checks that are not known yet are linted during the apply, by ValidateModel
+	model := ScorecardModel{EntityFilterSql: entityFilterSql}
+	if !checks.IsNull() && !checks.IsUnknown() {
+		if diags := checks.ElementsAs(ctx, &model.Checks, false); diags.HasError() {
+			model.Checks = nil
+		}
+	}
+	validateSQL(model, &resp.Diagnostics)
+}
+
 func ValidateModel(plan ScorecardModel, diags *diag.Diagnostics) {
 	// Validate required fields for CREATE endpoint
 	if plan.Name.IsNull() || plan.Name.IsUnknown() {
@@ -252,10 +313,6 @@ func ValidateModel(plan ScorecardModel, diags *diag.Diagnostics) {
 		}
 
 		for _, check := range plan.Checks {
-			if check.ScorecardLevelKey.IsNull() {
-				diags.AddError("Missing required field", "The 'scorecard_level_key' field must be specified for checks in LEVEL scorecards.")
-			}
-
 			levelKey := check.ScorecardLevelKey.ValueString()
 			if !levelKeys[levelKey] {
 				diags.AddError("Invalid value", fmt.Sprintf("The 'scorecard_level_key' field value of `%s` does not match any level keys", levelKey))
@@ -281,10 +338,6 @@ func ValidateModel(plan ScorecardModel, diags *diag.Diagnostics) {
 		}
 
 		for _, check := range plan.Checks {
-			if check.ScorecardCheckGroupKey.IsNull() {
-				diags.AddError("Missing required field", "The 'scorecard_check_group_key' field must be specified for checks in POINTS scorecards.")
-			}
-
 			checkGroupKey := check.ScorecardCheckGroupKey.ValueString()
 			if !checkGroupKeys[checkGroupKey] {
 				diags.AddError("Invalid value", fmt.Sprintf("The 'scorecard_check_group_key' field value of `%s` does not match any check group keys", checkGroupKey))
@@ -301,6 +354,93 @@ func ValidateModel(plan ScorecardModel, diags *diag.Diagnostics) {
 	default:
 		diags.AddError("Invalid scorecard type", fmt.Sprintf("Unsupported scorecard type: %s", scorecardType))
 	}
+
+	for _, key := range sortedCheckKeys(plan.Checks) {
+		validateCheck(plan.Checks[key], scorecardType, path.Root("checks").AtMapKey(key), diags)
+	}
+
+	validateSQL(plan, diags)
+}
+
+// validateCheck validates the fields of the check at checkPath that do not depend on the rest of
+// the scorecard. It is shared by inline checks and dx_scorecard_check, which only knows the
+// scorecard type once the scorecard has been read; the grouping key is not checked while
+// scorecardType is empty. Unknown values are skipped.
+func validateCheck(check CheckModel, scorecardType string, checkPath path.Path, diags *diag.Diagnostics) {
+	switch scorecardType {
+	case "LEVEL":
+		if check.ScorecardLevelKey.IsNull() {
+			diags.AddAttributeError(checkPath.AtName("scorecard_level_key"), "Missing required field", "The 'scorecard_level_key' field must be specified for checks in LEVEL scorecards.")
+		}
+	case "POINTS":
+		if check.ScorecardCheckGroupKey.IsNull() {
+			diags.AddAttributeError(checkPath.AtName("scorecard_check_group_key"), "Missing required field", "The 'scorecard_check_group_key' field must be specified for checks in POINTS scorecards.")
+		}
+	}
+
+	if check.OutputEnabled.IsUnknown() {
+		return
+	}
+	if check.OutputEnabled.ValueBool() {
+		if check.OutputType.IsNull() {
+			diags.AddAttributeError(checkPath.AtName("output_type"), "Missing required field", "The 'output_type' field must be specified for checks with 'output_enabled' set.")
+		}
+		if check.OutputType.ValueString() == "custom" && check.OutputCustomOptions == nil {
+			diags.AddAttributeError(checkPath.AtName("output_custom_options"), "Missing required field", "The 'output_custom_options' field must be specified for checks with an 'output_type' of `custom`.")
+		}
+		return
+	}
+
+	// Output fields are only sent to DX for checks with output enabled, so they would never be applied
+	outputFields := []struct {
+		name string
+		set  bool
+	}{
+		{"output_type", !check.OutputType.IsNull()},
+		{"output_aggregation", !check.OutputAggregation.IsNull()},
+		{"output_custom_options", check.OutputCustomOptions != nil},
+	}
+	for _, field := range outputFields {
+		if field.set {
+			diags.AddAttributeError(checkPath.AtName(field.name), "Invalid value", fmt.Sprintf("The '%s' field can only be set for checks with 'output_enabled' set.", field.name))
+		}
+	}
+}
+
+// validateSQL lints the scorecard's entity filter and check queries. Unknown queries are skipped.
+func validateSQL(plan ScorecardModel, diags *diag.Diagnostics) {
+	if !plan.EntityFilterSql.IsNull() && !plan.EntityFilterSql.IsUnknown() {
+		sqllint.AddDiagnostics(diags, path.Root("entity_filter_sql"), plan.EntityFilterSql.ValueString(), sqllint.EntityFilterRules)
+	}
+
+	for _, key := range sortedCheckKeys(plan.Checks) {
+		validateCheckSQL(plan.Checks[key], path.Root("checks").AtMapKey(key), diags)
+	}
+}
+
+// sortedCheckKeys returns the keys of checks in order, so diagnostics are reported in a stable order.
+func sortedCheckKeys(checks map[string]CheckModel) []string {
+	keys := make([]string, 0, len(checks))
+	for key := range checks {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// validateCheckSQL lints the queries of the check at checkPath. Checks with output enabled must
+// return an `output` column as well as `status`.
+func validateCheckSQL(check CheckModel, checkPath path.Path, diags *diag.Diagnostics) {
+	if !check.Sql.IsNull() && !check.Sql.IsUnknown() {
+		rules := sqllint.CheckRules
+		if check.OutputEnabled.ValueBool() {
+			rules = sqllint.CheckOutputRules
+		}
+		sqllint.AddDiagnostics(diags, checkPath.AtName("sql"), check.Sql.ValueString(), rules)
+	}
+	if !check.FilterSql.IsNull() && !check.FilterSql.IsUnknown() {
+		sqllint.AddDiagnostics(diags, checkPath.AtName("filter_sql"), check.FilterSql.ValueString(), sqllint.FilterRules)
+	}
 }
 
 // Validates that there are no duplicate ordering values for checks within the same container (level or check group).
@@ -367,13 +507,12 @@ func modelToRequestBody(ctx context.Context, plan ScorecardModel, setIds bool) (
 		"evaluation_frequency_hours": plan.EvaluationFrequency.ValueInt32(),
 	}
 
-	if len(plan.Tags) > 0 {
-		tags := []map[string]interface{}{}
-		for _, tag := range plan.Tags {
-			tags = append(tags, map[string]interface{}{"value": tag.Value.ValueString()})
-		}
-		payload["tags"] = tags
+	// Always send tags, so tags removed from the configuration are removed from the scorecard
+	tags := []map[string]interface{}{}
+	for _, tag := range plan.Tags {
+		tags = append(tags, map[string]interface{}{"value": tag.Value.ValueString()})
 	}
+	payload["tags"] = tags
 
 	if setIds {
 		payload["id"] = plan.Id.ValueString()
@@ -440,73 +579,83 @@ func modelToRequestBody(ctx context.Context, plan ScorecardModel, setIds bool) (
 	// Add checks
 	checks := []map[string]interface{}{}
 	for _, planCheck := range plan.Checks {
-		var estimatedDevDaysValue interface{}
-		if planCheck.EstimatedDevDays.IsNull() || planCheck.EstimatedDevDays.IsUnknown() {
-			estimatedDevDaysValue = nil
-		} else {
-			estimatedDevDaysValue = planCheck.EstimatedDevDays.ValueFloat32()
-		}
-
-		checkPayload := map[string]interface{}{
-			"name":               planCheck.Name.ValueString(),
-			"description":        planCheck.Description.ValueString(),
-			"ordering":           planCheck.Ordering.ValueInt32(),
-			"sql":                planCheck.Sql.ValueString(),
-			"filter_sql":         planCheck.FilterSql.ValueString(),
-			"filter_message":     planCheck.FilterMessage.ValueString(),
-			"output_enabled":     planCheck.OutputEnabled.ValueBool(),
-			"output_type":        nil,
-			"output_aggregation": nil,
-			"estimated_dev_days": estimatedDevDaysValue,
-			"external_url":       planCheck.ExternalUrl.ValueString(),
-			"published":          planCheck.Published.ValueBool(),
+		checkPayload, err := checkToRequestBody(planCheck, scorecardType, setIds)
+		if err != nil {
+			return nil, err
 		}
+		checks = append(checks, checkPayload)
+	}
+	payload["checks"] = checks
 
-		if setIds {
-			checkPayload["id"] = planCheck.Id.ValueString()
-		}
+	return payload, nil
+}
 
-		if checkPayload["output_enabled"] == true {
-			checkPayload["output_type"] = planCheck.OutputType.ValueString()
-			if !planCheck.OutputAggregation.IsNull() {
-				checkPayload["output_aggregation"] = planCheck.OutputAggregation.ValueString()
-			}
-		}
+// checkToRequestBody converts a check to its request payload. The level or check group is
+// referenced by its key in the scorecard request.
+func checkToRequestBody(planCheck CheckModel, scorecardType string, setIds bool) (map[string]interface{}, error) {
+	var estimatedDevDaysValue interface{}
+	if planCheck.EstimatedDevDays.IsNull() || planCheck.EstimatedDevDays.IsUnknown() {
+		estimatedDevDaysValue = nil
+	} else {
+		estimatedDevDaysValue = planCheck.EstimatedDevDays.ValueFloat32()
+	}
 
-		if planCheck.OutputType.ValueString() == "custom" {
-			planCustomOptions := planCheck.OutputCustomOptions
-			if planCustomOptions == nil {
-				return nil, fmt.Errorf("output_custom_options is required when output_type is `custom`")
-			}
+	checkPayload := map[string]interface{}{
+		"name":               planCheck.Name.ValueString(),
+		"description":        planCheck.Description.ValueString(),
+		"ordering":           planCheck.Ordering.ValueInt32(),
+		"sql":                planCheck.Sql.ValueString(),
+		"filter_sql":         planCheck.FilterSql.ValueString(),
+		"filter_message":     planCheck.FilterMessage.ValueString(),
+		"output_enabled":     planCheck.OutputEnabled.ValueBool(),
+		"output_type":        nil,
+		"output_aggregation": nil,
+		"estimated_dev_days": estimatedDevDaysValue,
+		"external_url":       planCheck.ExternalUrl.ValueString(),
+		"published":          planCheck.Published.ValueBool(),
+	}
 
-			planCustomOptionsVal := *planCustomOptions
-			customOptionsPayload := map[string]interface{}{
-				"unit":     planCustomOptionsVal.Unit.ValueString(),
-				"decimals": "auto",
-			}
-			if !planCustomOptionsVal.Decimals.IsNull() {
-				customOptionsPayload["decimals"] = planCustomOptionsVal.Decimals.ValueInt32()
-			}
+	if setIds {
+		checkPayload["id"] = planCheck.Id.ValueString()
+	}
 
-			checkPayload["output_custom_options"] = customOptionsPayload
+	if checkPayload["output_enabled"] == true {
+		checkPayload["output_type"] = planCheck.OutputType.ValueString()
+		if !planCheck.OutputAggregation.IsNull() {
+			checkPayload["output_aggregation"] = planCheck.OutputAggregation.ValueString()
 		}
+	}
 
-		// Add LEVEL-specific check fields
-		if scorecardType == "LEVEL" {
-			checkPayload["scorecard_level_key"] = planCheck.ScorecardLevelKey.ValueString()
+	if planCheck.OutputType.ValueString() == "custom" {
+		planCustomOptions := planCheck.OutputCustomOptions
+		if planCustomOptions == nil {
+			return nil, fmt.Errorf("output_custom_options is required when output_type is `custom`")
 		}
 
-		// Add POINTS-specific check fields
-		if scorecardType == "POINTS" {
-			checkPayload["scorecard_check_group_key"] = planCheck.ScorecardCheckGroupKey.ValueString()
-			checkPayload["points"] = planCheck.Points.ValueInt32()
+		planCustomOptionsVal := *planCustomOptions
+		customOptionsPayload := map[string]interface{}{
+			"unit":     planCustomOptionsVal.Unit.ValueString(),
+			"decimals": "auto",
+		}
+		if !planCustomOptionsVal.Decimals.IsNull() {
+			customOptionsPayload["decimals"] = planCustomOptionsVal.Decimals.ValueInt32()
 		}
 
-		checks = append(checks, checkPayload)
+		checkPayload["output_custom_options"] = customOptionsPayload
 	}
-	payload["checks"] = checks
 
-	return payload, nil
+	// Add LEVEL-specific check fields
+	if scorecardType == "LEVEL" {
+		checkPayload["scorecard_level_key"] = planCheck.ScorecardLevelKey.ValueString()
+	}
+
+	// Add POINTS-specific check fields
+	if scorecardType == "POINTS" {
+		checkPayload["scorecard_check_group_key"] = planCheck.ScorecardCheckGroupKey.ValueString()
+		checkPayload["points"] = planCheck.Points.ValueInt32()
+	}
+
+	return checkPayload, nil
 }
 
 func responseBodyToModel(ctx context.Context, apiResp *dxapi.APIResponse, state *ScorecardModel, oldPlan *ScorecardModel) {
@@ -588,6 +737,7 @@ func responseBodyToModel(ctx context.Context, apiResp *dxapi.APIResponse, state
 
 	// ************** Optional fields **************
 	state.Description = dx.StringOrNull(apiResp.Scorecard.Description)
+	state.Tags = tagsToModel(apiResp.Scorecard.Tags, oldPlan.Tags)
 	state.EntityFilterSql = dx.StringOrNullConvertEmpty(apiResp.Scorecard.EntityFilterSql)
 	state.Published = dx.BoolApiToTF(apiResp.Scorecard.Published, state.Published)
 
@@ -659,44 +809,137 @@ func responseBodyToModel(ctx context.Context, apiResp *dxapi.APIResponse, state
 			)
 		}
 
-		var outputCustomOptions *OutputCustomOptionsModel = nil
-		if chk.OutputCustomOptions != nil {
-			decimals := chk.OutputCustomOptions.Decimals
-			if decimals.IsAuto {
-				outputCustomOptions = &OutputCustomOptionsModel{
-					Unit:     types.StringValue(chk.OutputCustomOptions.Unit),
-					Decimals: types.Int32Null(),
-				}
-			} else {
-				decimalsValue := *decimals.FixedValue
-				outputCustomOptions = &OutputCustomOptionsModel{
-					Unit:     types.StringValue(chk.OutputCustomOptions.Unit),
-					Decimals: types.Int32Value(decimalsValue),
-				}
+		// Without a previous value (e.g. on import or in data sources), derive the grouping keys from
+		// the level or check group the API says the check belongs to
+		if levelKey == nil && chk.Level != nil && chk.Level.Id != nil {
+			levelKey = levelKeyForID(state.Levels, *chk.Level.Id)
+		}
+		if checkGroupKey == nil && chk.CheckGroup != nil && chk.CheckGroup.Id != nil {
+			checkGroupKey = checkGroupKeyForID(state.CheckGroups, *chk.CheckGroup.Id)
+		}
+
+		state.Checks[checkKey] = checkFromAPI(chk, levelKey, checkGroupKey)
+	}
+}
+
+// checkFromAPI maps a check to state. The API does not return grouping keys, so the caller
+// provides them.
+func checkFromAPI(chk *dxapi.APICheck, levelKey, checkGroupKey *string) CheckModel {
+	var outputCustomOptions *OutputCustomOptionsModel = nil
+	if chk.OutputCustomOptions != nil {
+		decimals := chk.OutputCustomOptions.Decimals
+		if decimals.IsAuto {
+			outputCustomOptions = &OutputCustomOptionsModel{
+				Unit:     types.StringValue(chk.OutputCustomOptions.Unit),
+				Decimals: types.Int32Null(),
+			}
+		} else {
+			decimalsValue := *decimals.FixedValue
+			outputCustomOptions = &OutputCustomOptionsModel{
+				Unit:     types.StringValue(chk.OutputCustomOptions.Unit),
+				Decimals: types.Int32Value(decimalsValue),
 			}
 		}
+	}
+
+	return CheckModel{
+		Id:                  dx.StringOrNull(chk.Id),
+		Name:                dx.StringOrNull(chk.Name),
+		Description:         dx.StringOrNullConvertEmpty(chk.Description),
+		Ordering:            types.Int32Value(chk.Ordering),
+		Sql:                 dx.StringOrNull(chk.Sql),
+		FilterSql:           dx.StringOrNullConvertEmpty(chk.FilterSql),
+		FilterMessage:       dx.StringOrNullConvertEmpty(chk.FilterMessage),
+		OutputEnabled:       types.BoolValue(chk.OutputEnabled),
+		OutputType:          dx.StringOrNull(chk.OutputType),
+		OutputAggregation:   dx.StringOrNull(chk.OutputAggregation),
+		OutputCustomOptions: outputCustomOptions,
+		EstimatedDevDays:    dx.Float32OrNull(chk.EstimatedDevDays),
+		ExternalUrl:         dx.StringOrNullConvertEmpty(chk.ExternalUrl),
+		Published:           types.BoolValue(chk.Published),
+		Points:              dx.Int32OrNull(chk.Points),
+
+		ScorecardLevelKey:      dx.StringOrNull(levelKey),
+		ScorecardCheckGroupKey: dx.StringOrNull(checkGroupKey),
+	}
+}
+
+// externalCheckToRequestBody converts a check that is not declared in the plan to its request
+// payload, keeping its ID so the update leaves it unchanged.
+func externalCheckToRequestBody(chk *dxapi.APICheck, plan ScorecardModel) (map[string]interface{}, error) {
+	var levelKey, checkGroupKey *string
+	if chk.Level != nil && chk.Level.Id != nil {
+		if levelKey = levelKeyForID(plan.Levels, *chk.Level.Id); levelKey == nil {
+			return nil, fmt.Errorf("check %q belongs to a level that is being removed from the scorecard", dx.StringOrNull(chk.Name).ValueString())
+		}
+	}
+	if chk.CheckGroup != nil && chk.CheckGroup.Id != nil {
+		if checkGroupKey = checkGroupKeyForID(plan.CheckGroups, *chk.CheckGroup.Id); checkGroupKey == nil {
+			return nil, fmt.Errorf("check %q belongs to a check group that is being removed from the scorecard", dx.StringOrNull(chk.Name).ValueString())
+		}
+	}
+	return checkToRequestBody(checkFromAPI(chk, levelKey, checkGroupKey), plan.Type.ValueString(), true)
+}
+
+// withoutChecks returns a copy of the response without the checks for which drop returns true.
+// Checks without an ID are always kept.
+func withoutChecks(apiResp *dxapi.APIResponse, drop func(*dxapi.APICheck) bool) *dxapi.APIResponse {
+	filtered := *apiResp
+	filtered.Scorecard.Checks = make([]*dxapi.APICheck, 0, len(apiResp.Scorecard.Checks))
+	for _, chk := range apiResp.Scorecard.Checks {
+		if chk.Id == nil || !drop(chk) {
+			filtered.Scorecard.Checks = append(filtered.Scorecard.Checks, chk)
+		}
+	}
+	return &filtered
+}
+
+// checkIDs returns the IDs of the checks.
+func checkIDs(checks map[string]CheckModel) map[string]bool {
+	ids := make(map[string]bool, len(checks))
+	for _, check := range checks {
+		if !check.Id.IsNull() && !check.Id.IsUnknown() {
+			ids[check.Id.ValueString()] = true
+		}
+	}
+	return ids
+}
+
+// levelKeyForID returns the key of the level with the given ID, or nil if there is none.
+func levelKeyForID(levels map[string]LevelModel, id string) *string {
+	for key, level := range levels {
+		if level.Id.ValueString() == id {
+			return &key
+		}
+	}
+	return nil
+}
 
-		state.Checks[checkKey] = CheckModel{
-			Id:                  dx.StringOrNull(chk.Id),
-			Name:                dx.StringOrNull(chk.Name),
-			Description:         dx.StringOrNullConvertEmpty(chk.Description),
-			Ordering:            types.Int32Value(chk.Ordering),
-			Sql:                 dx.StringOrNull(chk.Sql),
-			FilterSql:           dx.StringOrNullConvertEmpty(chk.FilterSql),
-			FilterMessage:       dx.StringOrNullConvertEmpty(chk.FilterMessage),
-			OutputEnabled:       types.BoolValue(chk.OutputEnabled),
-			OutputType:          dx.StringOrNull(chk.OutputType),
-			OutputAggregation:   dx.StringOrNull(chk.OutputAggregation),
-			OutputCustomOptions: outputCustomOptions,
-			EstimatedDevDays:    dx.Float32OrNull(chk.EstimatedDevDays),
-			ExternalUrl:         dx.StringOrNullConvertEmpty(chk.ExternalUrl),
-			Published:           types.BoolValue(chk.Published),
-			Points:              dx.Int32OrNull(chk.Points),
+// checkGroupKeyForID returns the key of the check group with the given ID, or nil if there is none.
+func checkGroupKeyForID(groups map[string]CheckGroupModel, id string) *string {
+	for key, group := range groups {
+		if group.Id.ValueString() == id {
+			return &key
+		}
+	}
+	return nil
+}
 
-			ScorecardLevelKey:      dx.StringOrNull(levelKey),
-			ScorecardCheckGroupKey: dx.StringOrNull(checkGroupKey),
+// tagsToModel maps the scorecard's tags to state. An empty `tags` set in the prior plan or state
+// is kept as an empty set rather than null, so it does not show up as a difference.
+func tagsToModel(apiTags []dxapi.APITag, oldTags []TagModel) []TagModel {
+	if len(apiTags) == 0 {
+		if oldTags != nil {
+			return []TagModel{}
 		}
+		return nil
+	}
+
+	tags := make([]TagModel, 0, len(apiTags))
+	for _, tag := range apiTags {
+		tags = append(tags, TagModel{Value: types.StringValue(tag.Value)})
 	}
+	return tags
 }
 
 // Convert a level/check-group/check name to a key.
@@ -0,0 +1,303 @@
+package scorecard
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-dx/dx"
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &ScorecardCheckResource{}
+	_ resource.ResourceWithImportState    = &ScorecardCheckResource{}
+	_ resource.ResourceWithValidateConfig = &ScorecardCheckResource{}
+)
+
+func NewScorecardCheckResource() resource.Resource {
+	return &ScorecardCheckResource{}
+}
+
+// ScorecardCheckResource manages one check of a scorecard through the check-level API, so
+// checks can be owned by other configurations than the scorecard itself.
+type ScorecardCheckResource struct {
+	client *dxapi.Client
+}
+
+func (r *ScorecardCheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scorecard_check"
+}
+
+func (r *ScorecardCheckResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dxapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dxapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+// ValidateConfig validates the check like the checks of dx_scorecard and lints its SQL queries.
+// The grouping key depends on the scorecard type, so it is validated once the scorecard is read.
+func (r *ScorecardCheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var check CheckModel
+	var outputCustomOptions types.Object
+	for name, target := range map[string]interface{}{
+		"sql":                       &check.Sql,
+		"filter_sql":                &check.FilterSql,
+		"output_enabled":            &check.OutputEnabled,
+		"output_type":               &check.OutputType,
+		"output_aggregation":        &check.OutputAggregation,
+		"output_custom_options":     &outputCustomOptions,
+		"scorecard_level_key":       &check.ScorecardLevelKey,
+		"scorecard_check_group_key": &check.ScorecardCheckGroupKey,
+	} {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), target)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Only whether the options are set matters, and their values may not be known yet
+	if !outputCustomOptions.IsNull() {
+		check.OutputCustomOptions = &OutputCustomOptionsModel{}
+	}
+
+	validateCheck(check, "", path.Empty(), &resp.Diagnostics)
+	validateCheckSQL(check, path.Empty(), &resp.Diagnostics)
+}
+
+func (r *ScorecardCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating scorecard check resource")
+
+	var plan ScorecardCheckModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := r.requestBody(ctx, plan, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.CreateScorecardCheck(ctx, payload)
+	if err != nil {
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error creating scorecard check", err)
+		return
+	}
+
+	checkResponseToModel(ctx, &apiResp.Check, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ScorecardCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading scorecard check resource")
+
+	var state ScorecardCheckModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scorecardId, id := state.ScorecardId.ValueString(), state.Id.ValueString()
+	apiResp, err := r.client.GetScorecardCheck(ctx, scorecardId, id)
+	if err != nil {
+		// Deleting the scorecard deletes its checks too
+		if dxapi.IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Check %s of scorecard %s not found, removing from state", id, scorecardId))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading scorecard check",
+			fmt.Sprintf("Could not read check %s of scorecard %s: %s", id, scorecardId, err.Error()),
+		)
+		return
+	}
+
+	checkResponseToModel(ctx, &apiResp.Check, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ScorecardCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ScorecardCheckModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := r.requestBody(ctx, plan, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.UpdateScorecardCheck(ctx, payload)
+	if err != nil {
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error updating scorecard check", err)
+		return
+	}
+
+	checkResponseToModel(ctx, &apiResp.Check, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ScorecardCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ScorecardCheckModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	success, err := r.client.DeleteScorecardCheck(ctx, state.ScorecardId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error deleting scorecard check", err)
+		return
+	}
+	if !success {
+		resp.Diagnostics.AddError("Error deleting scorecard check", "API did not confirm deletion.")
+		return
+	}
+}
+
+func (r *ScorecardCheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing scorecard check state")
+
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form <scorecard_id>:<check_id>, got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scorecard_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// requestBody converts the plan to a check request. The check API references the level or check
+// group by ID, so the key is resolved against the scorecard's current levels or check groups.
+func (r *ScorecardCheckResource) requestBody(ctx context.Context, plan ScorecardCheckModel, setIds bool, diags *diag.Diagnostics) map[string]interface{} {
+	scorecardId := plan.ScorecardId.ValueString()
+	apiResp, err := r.client.GetScorecard(ctx, scorecardId)
+	if err != nil {
+		if dxapi.IsNotFound(err) {
+			diags.AddAttributeError(path.Root("scorecard_id"), "Scorecard not found", fmt.Sprintf("No scorecard with ID %q exists.", scorecardId))
+			return nil
+		}
+		dx.AddAPIError(ctx, diags, nil, "Error reading scorecard", err)
+		return nil
+	}
+	sc := apiResp.Scorecard
+
+	validateCheck(plan.CheckModel, sc.Type, path.Empty(), diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	payload, err := checkToRequestBody(plan.CheckModel, sc.Type, setIds)
+	if err != nil {
+		diags.AddError("Error converting plan to request body", err.Error())
+		return nil
+	}
+	delete(payload, "scorecard_level_key")
+	delete(payload, "scorecard_check_group_key")
+	payload["scorecard_id"] = scorecardId
+
+	switch sc.Type {
+	case "LEVEL":
+		var levels []grouping
+		for _, level := range sc.Levels {
+			if level.Id != nil && level.Name != nil {
+				levels = append(levels, grouping{id: *level.Id, name: *level.Name})
+			}
+		}
+		if id, ok := groupingID(ctx, &sc, "level", "scorecard_level_key", plan.ScorecardLevelKey.ValueString(), levels, diags); ok {
+			payload["scorecard_level_id"] = id
+			return payload
+		}
+	case "POINTS":
+		var groups []grouping
+		for _, group := range sc.CheckGroups {
+			if group.Id != nil && group.Name != nil {
+				groups = append(groups, grouping{id: *group.Id, name: *group.Name})
+			}
+		}
+		if id, ok := groupingID(ctx, &sc, "check group", "scorecard_check_group_key", plan.ScorecardCheckGroupKey.ValueString(), groups, diags); ok {
+			payload["scorecard_check_group_id"] = id
+			return payload
+		}
+	default:
+		diags.AddError("Invalid scorecard type", fmt.Sprintf("Unsupported scorecard type: %s", sc.Type))
+	}
+	return nil
+}
+
+// grouping is a level or check group of a scorecard.
+type grouping struct {
+	id, name string
+}
+
+// groupingID returns the ID of the level or check group whose snake cased name is key. kind names
+// the grouping in errors, and attribute is the attribute holding the key. Names that only differ
+// in case or punctuation can map to the same key, which is reported instead of picking one.
+func groupingID(ctx context.Context, sc *dxapi.APIScorecard, kind, attribute, key string, groupings []grouping, diags *diag.Diagnostics) (string, bool) {
+	var matches []grouping
+	for _, g := range groupings {
+		if nameToKey(ctx, g.name) == key {
+			matches = append(matches, g)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddAttributeError(path.Root(attribute), "Unknown scorecard "+kind,
+			fmt.Sprintf("Scorecard %q has no %s with the key %q. %s scorecards need `%s` set to the snake cased name of one of their %ss.", sc.Name, kind, key, sc.Type, attribute, kind))
+		return "", false
+	case 1:
+		return matches[0].id, true
+	default:
+		names := make([]string, len(matches))
+		for i, g := range matches {
+			names[i] = fmt.Sprintf("%q", g.name)
+		}
+		diags.AddAttributeError(path.Root(attribute), "Ambiguous scorecard "+kind,
+			fmt.Sprintf("The %ss %s of scorecard %q all have the key %q. Rename them so their snake cased names differ.", kind, strings.Join(names, ", "), sc.Name, key))
+		return "", false
+	}
+}
+
+// checkResponseToModel maps a check to state. The grouping keys are the snake cased name of the
+// level or check group, which is what requestBody resolves them by.
+func checkResponseToModel(ctx context.Context, chk *dxapi.APICheck, state *ScorecardCheckModel) {
+	var levelKey, checkGroupKey *string
+	if chk.Level != nil && chk.Level.Name != nil {
+		key := nameToKey(ctx, *chk.Level.Name)
+		levelKey = &key
+	}
+	if chk.CheckGroup != nil && chk.CheckGroup.Name != nil {
+		key := nameToKey(ctx, *chk.CheckGroup.Name)
+		checkGroupKey = &key
+	}
+
+	state.CheckModel = checkFromAPI(chk, levelKey, checkGroupKey)
+}
@@ -0,0 +1,131 @@
+package scorecard_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-dx/internal/acctest"
+)
+
+func testAccDxScorecardCheckConfig(scorecardName, inlineDescription, contributedLevel string) string {
+	return fmt.Sprintf(`
+provider "dx" {}
+
+resource "dx_scorecard" "shared" {
+  name                           = "%s"
+  type                           = "LEVEL"
+  entity_filter_type             = "entity_types"
+  entity_filter_type_identifiers = ["service"]
+  evaluation_frequency_hours     = 2
+  empty_level_label              = "Incomplete"
+  empty_level_color              = "#cccccc"
+  ignore_external_checks         = true
+
+  levels = {
+    bronze = {
+      name  = "Bronze"
+      color = "#FB923C"
+      rank  = 1
+    },
+    silver = {
+      name  = "Silver"
+      color = "#9CA3AF"
+      rank  = 2
+    },
+  }
+
+  checks = {
+    inline_check = {
+      name                = "Inline Check"
+      description         = "%s"
+      scorecard_level_key = "bronze"
+      ordering            = 0
+      sql                 = "select 'PASS' as status"
+      output_enabled      = false
+      published           = true
+    }
+  }
+}
+
+resource "dx_scorecard_check" "contributed" {
+  scorecard_id        = dx_scorecard.shared.id
+  scorecard_level_key = "%s"
+  name                = "Contributed Check"
+  ordering            = 1
+  sql                 = "select 'PASS' as status"
+  output_enabled      = false
+  published           = true
+}
+`, scorecardName, inlineDescription, contributedLevel)
+}
+
+func TestAccDxScorecardCheckResource(t *testing.T) {
+	scorecardName := fmt.Sprintf("Terraform Provider Shared Scorecard %d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The scorecard does not read the contributed check into its inline checks
+			{
+				Config: testAccDxScorecardCheckConfig(scorecardName, "Managed inline", "bronze"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dx_scorecard.shared", "checks.%", "1"),
+					resource.TestCheckResourceAttrSet("dx_scorecard.shared", "checks.inline_check.id"),
+					resource.TestCheckResourceAttrSet("dx_scorecard_check.contributed", "id"),
+					resource.TestCheckResourceAttrPair("dx_scorecard_check.contributed", "scorecard_id", "dx_scorecard.shared", "id"),
+					resource.TestCheckResourceAttr("dx_scorecard_check.contributed", "scorecard_level_key", "bronze"),
+				),
+			},
+			// Updating the scorecard keeps the contributed check, which is moved to another level on its own
+			{
+				Config: testAccDxScorecardCheckConfig(scorecardName, "Changed inline", "silver"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dx_scorecard.shared", "checks.%", "1"),
+					resource.TestCheckResourceAttr("dx_scorecard.shared", "checks.inline_check.description", "Changed inline"),
+					resource.TestCheckResourceAttr("dx_scorecard_check.contributed", "scorecard_level_key", "silver"),
+				),
+			},
+			{
+				ResourceName:      "dx_scorecard_check.contributed",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["dx_scorecard_check.contributed"]
+					return rs.Primary.Attributes["scorecard_id"] + ":" + rs.Primary.ID, nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccDxScorecardCheckResourceInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Checks are validated like the inline checks of dx_scorecard, before anything is created
+			{
+				Config: `
+provider "dx" {}
+
+resource "dx_scorecard_check" "invalid" {
+  scorecard_id        = "does-not-matter"
+  scorecard_level_key = "bronze"
+  name                = "Coverage"
+  ordering            = 0
+  sql                 = "select 'PASS' as status, 1 as output"
+  output_enabled      = true
+  published           = true
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`The 'output_type' field must be specified`),
+			},
+		},
+	})
+}
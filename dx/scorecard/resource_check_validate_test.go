@@ -0,0 +1,143 @@
+package scorecard
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestValidateCheck verifies the structural checks shared by inline checks and dx_scorecard_check.
+func TestValidateCheck(t *testing.T) {
+	valid := func() CheckModel {
+		return CheckModel{
+			Sql:                    types.StringValue("select 'PASS' as status"),
+			OutputEnabled:          types.BoolValue(false),
+			ScorecardCheckGroupKey: types.StringValue("basics"),
+		}
+	}
+
+	tests := []struct {
+		name          string
+		scorecardType string
+		modify        func(*CheckModel)
+		wantPaths     []path.Path
+	}{
+		{
+			name:          "valid",
+			scorecardType: "POINTS",
+			modify:        func(c *CheckModel) {},
+		},
+		{
+			name:          "grouping key for another scorecard type",
+			scorecardType: "LEVEL",
+			modify:        func(c *CheckModel) {},
+			wantPaths:     []path.Path{path.Root("scorecard_level_key")},
+		},
+		{
+			name:          "grouping key not checked without scorecard type",
+			scorecardType: "",
+			modify:        func(c *CheckModel) { c.ScorecardCheckGroupKey = types.StringNull() },
+		},
+		{
+			name:          "output enabled without output type",
+			scorecardType: "POINTS",
+			modify:        func(c *CheckModel) { c.OutputEnabled = types.BoolValue(true) },
+			wantPaths:     []path.Path{path.Root("output_type")},
+		},
+		{
+			name:          "custom output without options",
+			scorecardType: "POINTS",
+			modify: func(c *CheckModel) {
+				c.OutputEnabled = types.BoolValue(true)
+				c.OutputType = types.StringValue("custom")
+			},
+			wantPaths: []path.Path{path.Root("output_custom_options")},
+		},
+		{
+			name:          "output fields without output enabled",
+			scorecardType: "POINTS",
+			modify: func(c *CheckModel) {
+				c.OutputType = types.StringValue("custom")
+				c.OutputAggregation = types.StringValue("median")
+				c.OutputCustomOptions = &OutputCustomOptionsModel{Unit: types.StringValue("widget")}
+			},
+			wantPaths: []path.Path{path.Root("output_type"), path.Root("output_aggregation"), path.Root("output_custom_options")},
+		},
+		{
+			name:          "output enabled unknown",
+			scorecardType: "POINTS",
+			modify: func(c *CheckModel) {
+				c.OutputEnabled = types.BoolUnknown()
+				c.OutputType = types.StringValue("number")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := valid()
+			tt.modify(&check)
+
+			var diags diag.Diagnostics
+			validateCheck(check, tt.scorecardType, path.Empty(), &diags)
+
+			if len(diags) != len(tt.wantPaths) {
+				t.Fatalf("Expected %d errors, got %d: %v", len(tt.wantPaths), len(diags), diags)
+			}
+			for i, want := range tt.wantPaths {
+				withPath, ok := diags[i].(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(want) {
+					t.Errorf("Expected error %d on %s, got %v", i, want, diags[i])
+				}
+			}
+		})
+	}
+}
+
+// TestGroupingID verifies that a key is resolved to exactly one level or check group, and that
+// names mapping to the same key are reported instead of silently picking the first.
+func TestGroupingID(t *testing.T) {
+	sc := &dxapi.APIScorecard{Name: "Production Readiness", Type: "LEVEL"}
+	levels := []grouping{
+		{id: "l1", name: "Bronze"},
+		{id: "l2", name: "Fully Compliant"},
+		{id: "l3", name: "fully-compliant"},
+	}
+
+	tests := []struct {
+		name        string
+		key         string
+		wantID      string
+		wantSummary string
+	}{
+		{name: "unique", key: "bronze", wantID: "l1"},
+		{name: "unknown", key: "gold", wantSummary: "Unknown scorecard level"},
+		{name: "ambiguous", key: "fully_compliant", wantSummary: "Ambiguous scorecard level"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			id, ok := groupingID(context.Background(), sc, "level", "scorecard_level_key", tt.key, levels, &diags)
+
+			if tt.wantSummary == "" {
+				if !ok || id != tt.wantID || diags.HasError() {
+					t.Fatalf("Expected ID %q, got %q (ok: %t): %v", tt.wantID, id, ok, diags)
+				}
+				return
+			}
+			if ok || diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != tt.wantSummary {
+				t.Fatalf("Expected a %q error, got ID %q: %v", tt.wantSummary, id, diags)
+			}
+			if tt.name == "ambiguous" && !strings.Contains(diags.Errors()[0].Detail(), `"Fully Compliant", "fully-compliant"`) {
+				t.Errorf("Expected both level names in the error, got %q", diags.Errors()[0].Detail())
+			}
+		})
+	}
+}
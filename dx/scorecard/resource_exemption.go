@@ -0,0 +1,196 @@
+package scorecard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-dx/dx"
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &ExemptionResource{}
+	_ resource.ResourceWithImportState    = &ExemptionResource{}
+	_ resource.ResourceWithValidateConfig = &ExemptionResource{}
+)
+
+func NewExemptionResource() resource.Resource {
+	return &ExemptionResource{}
+}
+
+// ExemptionResource manages an exemption of an entity from a scorecard check.
+type ExemptionResource struct {
+	client *dxapi.Client
+}
+
+func (r *ExemptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scorecard_check_exemption"
+}
+
+func (r *ExemptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dxapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dxapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+// ValidateConfig checks the format of `expires_at`. Whether it is in the future is left to the
+// API, so plans of unchanged exemptions keep working after they expire.
+func (r *ExemptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var expiresAt types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+	if resp.Diagnostics.HasError() || expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, expiresAt.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_at"),
+			"Invalid expiration time",
+			fmt.Sprintf("Expected an RFC 3339 timestamp, e.g. 2027-01-31T00:00:00Z, got %q.", expiresAt.ValueString()),
+		)
+	}
+}
+
+func (r *ExemptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating scorecard check exemption resource")
+
+	var plan ExemptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := map[string]interface{}{
+		"scorecard_id":      plan.ScorecardId.ValueString(),
+		"check_id":          plan.CheckId.ValueString(),
+		"entity_identifier": plan.EntityIdentifier.ValueString(),
+		"reason":            plan.Reason.ValueString(),
+		"expires_at":        plan.ExpiresAt.ValueStringPointer(),
+	}
+
+	apiResp, err := r.client.CreateExemption(ctx, payload)
+	if err != nil {
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error creating scorecard check exemption", err)
+		return
+	}
+
+	exemptionToModel(&apiResp.Exemption, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ExemptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading scorecard check exemption resource")
+
+	var state ExemptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.GetExemption(ctx, state.Id.ValueString())
+	if err != nil {
+		// Deleting the scorecard, check or entity deletes its exemptions too
+		if dxapi.IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Exemption %s not found, removing from state", state.Id.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading scorecard check exemption",
+			fmt.Sprintf("Could not read exemption %s: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	exemptionToModel(&apiResp.Exemption, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ExemptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ExemptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := map[string]interface{}{
+		"id":         plan.Id.ValueString(),
+		"reason":     plan.Reason.ValueString(),
+		"expires_at": plan.ExpiresAt.ValueStringPointer(),
+	}
+
+	apiResp, err := r.client.UpdateExemption(ctx, payload)
+	if err != nil {
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error updating scorecard check exemption", err)
+		return
+	}
+
+	exemptionToModel(&apiResp.Exemption, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ExemptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ExemptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	success, err := r.client.DeleteExemption(ctx, state.Id.ValueString())
+	if err != nil {
+		dx.AddAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Error deleting scorecard check exemption", err)
+		return
+	}
+	if !success {
+		resp.Diagnostics.AddError("Error deleting scorecard check exemption", "API did not confirm deletion.")
+		return
+	}
+}
+
+func (r *ExemptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing scorecard check exemption state")
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// exemptionToModel maps an exemption to state. An `expires_at` denoting the same time as the
+// one in state is kept as written, since DX returns it in UTC.
+func exemptionToModel(ex *dxapi.APIExemption, state *ExemptionModel) {
+	state.Id = types.StringValue(ex.Id)
+	state.ScorecardId = types.StringValue(ex.ScorecardId)
+	state.CheckId = types.StringValue(ex.CheckId)
+	state.EntityIdentifier = types.StringValue(ex.EntityIdentifier)
+	state.Reason = types.StringValue(ex.Reason)
+	state.CreatedAt = types.StringValue(ex.CreatedAt)
+
+	if ex.ExpiresAt == nil || !sameTime(state.ExpiresAt.ValueString(), *ex.ExpiresAt) {
+		state.ExpiresAt = types.StringPointerValue(ex.ExpiresAt)
+	}
+}
+
+// sameTime reports whether two RFC 3339 timestamps denote the same time.
+func sameTime(a, b string) bool {
+	ta, errA := time.Parse(time.RFC3339, a)
+	tb, errB := time.Parse(time.RFC3339, b)
+	return errA == nil && errB == nil && ta.Equal(tb)
+}
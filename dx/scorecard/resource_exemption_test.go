@@ -0,0 +1,131 @@
+package scorecard_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"terraform-provider-dx/internal/acctest"
+)
+
+func TestAccDxScorecardCheckExemptionResource(t *testing.T) {
+	entityType := fmt.Sprintf("tfexempt%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// expires_at must be a timestamp
+			{
+				Config:      testAccExemptionConfig(entityType, "Legacy service", "next year"),
+				ExpectError: regexp.MustCompile(`Expected an RFC 3339 timestamp`),
+				PlanOnly:    true,
+			},
+			// DX only accepts expiry times in the future
+			{
+				Config:      testAccExemptionConfig(entityType, "Legacy service", "2020-01-01T00:00:00Z"),
+				ExpectError: regexp.MustCompile(`must be in the future`),
+			},
+			// Create an exemption and list it
+			{
+				Config: testAccExemptionConfig(entityType, "Legacy service", "2099-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dx_scorecard_check_exemption.test", "id"),
+					resource.TestCheckResourceAttrSet("dx_scorecard_check_exemption.test", "created_at"),
+					resource.TestCheckResourceAttrPair("dx_scorecard.test", "checks.has_runbook.id", "dx_scorecard_check_exemption.test", "check_id"),
+					resource.TestCheckResourceAttr("dx_scorecard_check_exemption.test", "expires_at", "2099-01-01T00:00:00Z"),
+
+					resource.TestCheckResourceAttr("data.dx_scorecard_check_exemptions.test", "exemptions.#", "1"),
+					resource.TestCheckResourceAttrPair("dx_scorecard_check_exemption.test", "id", "data.dx_scorecard_check_exemptions.test", "exemptions.0.id"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_check_exemptions.test", "exemptions.0.reason", "Legacy service"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_check_exemptions.test", "exemptions.0.entity_identifier", entityType+"-legacy"),
+				),
+			},
+			// Update the reason and extend the exemption in place
+			{
+				Config: testAccExemptionConfig(entityType, "Legacy service, retired in Q3", "2100-01-01T02:00:00+02:00"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dx_scorecard_check_exemption.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dx_scorecard_check_exemption.test", "reason", "Legacy service, retired in Q3"),
+					resource.TestCheckResourceAttr("dx_scorecard_check_exemption.test", "expires_at", "2100-01-01T02:00:00+02:00"),
+					resource.TestCheckResourceAttr("data.dx_scorecard_check_exemptions.test", "exemptions.0.reason", "Legacy service, retired in Q3"),
+				),
+			},
+			// Import by ID
+			{
+				ResourceName:      "dx_scorecard_check_exemption.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// DX returns the expiry in UTC, rather than in the time zone it was written in
+				ImportStateVerifyIgnore: []string{"expires_at"},
+			},
+		},
+	})
+}
+
+func testAccExemptionConfig(entityType, reason, expiresAt string) string {
+	return fmt.Sprintf(`
+provider "dx" {}
+
+resource "dx_entity_type" "test" {
+  identifier = "%[1]s"
+  name       = "Exemption Test"
+}
+
+resource "dx_entity" "legacy" {
+  identifier = "%[1]s-legacy"
+  type       = dx_entity_type.test.identifier
+  name       = "Legacy"
+}
+
+resource "dx_scorecard" "test" {
+  name                           = "Exemptions %[1]s"
+  type                           = "LEVEL"
+  entity_filter_type             = "entity_types"
+  entity_filter_type_identifiers = [dx_entity_type.test.identifier]
+  evaluation_frequency_hours     = 2
+  empty_level_label              = "Incomplete"
+  empty_level_color              = "#cccccc"
+
+  levels = {
+    bronze = {
+      name  = "Bronze"
+      color = "#FB923C"
+      rank  = 1
+    },
+  }
+
+  checks = {
+    has_runbook = {
+      name                = "Has Runbook"
+      scorecard_level_key = "bronze"
+      ordering            = 0
+      sql                 = "select 'FAIL' as status"
+      output_enabled      = false
+      published           = true
+    }
+  }
+}
+
+resource "dx_scorecard_check_exemption" "test" {
+  scorecard_id      = dx_scorecard.test.id
+  check_id          = dx_scorecard.test.checks.has_runbook.id
+  entity_identifier = dx_entity.legacy.identifier
+  reason            = %[2]q
+  expires_at        = %[3]q
+}
+
+data "dx_scorecard_check_exemptions" "test" {
+  scorecard_id = dx_scorecard.test.id
+
+  depends_on = [dx_scorecard_check_exemption.test]
+}
+`, entityType, reason, expiresAt)
+}
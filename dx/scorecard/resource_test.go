@@ -2,12 +2,16 @@ package scorecard_test
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
+	"terraform-provider-dx/dx/dxapi"
 	"terraform-provider-dx/dx/scorecard"
 	"terraform-provider-dx/internal/acctest"
 )
@@ -119,6 +123,44 @@ func TestDuplicateOrderingWithinCheckGroup(t *testing.T) {
 	}
 }
 
+func TestValidateModelLintsCheckSQL(t *testing.T) {
+	var model = scorecard.ScorecardModel{
+		Name:                types.StringValue("Terraform Provider SQL Scorecard"),
+		Type:                types.StringValue("POINTS"),
+		EntityFilterType:    types.StringValue("sql"),
+		EntityFilterSql:     types.StringValue("select identifier from dx_catalog_entities"),
+		EvaluationFrequency: types.Int32Value(2),
+		CheckGroups: map[string]scorecard.CheckGroupModel{
+			"basics": {Name: types.StringValue("Basics"), Ordering: types.Int32Value(0)},
+		},
+		Checks: map[string]scorecard.CheckModel{
+			"with_output": {
+				Name:                   types.StringValue("With output"),
+				ScorecardCheckGroupKey: types.StringValue("basics"),
+				Ordering:               types.Int32Value(0),
+				Points:                 types.Int32Value(1),
+				Sql:                    types.StringValue("select 'PASS' as status"),
+				OutputEnabled:          types.BoolValue(true),
+				OutputType:             types.StringValue("number"),
+			},
+		},
+	}
+
+	diags := diag.Diagnostics{}
+	scorecard.ValidateModel(model, &diags)
+
+	if len(diags) != 1 {
+		t.Fatalf("Expected 1 validation error, got %d: %v", len(diags), diags)
+	}
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root("checks").AtMapKey("with_output").AtName("sql")) {
+		t.Errorf("Expected the error on checks[\"with_output\"].sql, got %v", diags[0])
+	}
+	if !strings.Contains(diags[0].Detail(), `must return a column named "output"`) {
+		t.Errorf("Expected a missing output column error, got %q", diags[0].Detail())
+	}
+}
+
 func TestAccDxScorecardResourceCreateScorecard(t *testing.T) {
 	scorecardName := fmt.Sprintf("Terraform Provider Scorecard %d", acctest.RandInt())
 	var testAccDxScorecardResourceBasic = fmt.Sprintf(`
@@ -231,8 +273,107 @@ resource "dx_scorecard" "level_based_example" {
 				Config: testAccDxScorecardResourceBasic,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("dx_scorecard.level_based_example", "name", scorecardName),
+					resource.TestCheckResourceAttr("dx_scorecard.level_based_example", "tags.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("dx_scorecard.level_based_example", "tags.*", map[string]string{"value": "production"}),
 				),
 			},
+			// Importing the scorecard should read back its tags, and the level each check belongs to
+			{
+				ResourceName:      "dx_scorecard.level_based_example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDxScorecardResourceDeletedOutOfBand(t *testing.T) {
+	scorecardName := fmt.Sprintf("Terraform Provider Disappearing Scorecard %d", acctest.RandInt())
+	var testAccDxScorecardResourceDisappears = fmt.Sprintf(`
+provider "dx" {}
+
+resource "dx_scorecard" "disappears" {
+  name                           = "%s"
+  type                           = "POINTS"
+  entity_filter_type             = "entity_types"
+  entity_filter_type_identifiers = ["service"]
+  evaluation_frequency_hours     = 2
+
+  check_groups = {
+    basics = {
+      name     = "Basics"
+      ordering = 0
+    }
+  }
+
+  checks = {
+    passing_check = {
+      name                      = "Passing Check"
+      scorecard_check_group_key = "basics"
+      ordering                  = 0
+      points                    = 1
+      sql                       = "select 'PASS' as status"
+      output_enabled            = false
+      published                 = true
+    }
+  }
+}
+`, scorecardName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Deleting the scorecard outside of Terraform should plan a re-create, not fail the refresh
+			{
+				Config:             testAccDxScorecardResourceDisappears,
+				Check:              acctest.CheckDeletedOutOfBand("dx_scorecard.disappears", "id", (*dxapi.Client).DeleteScorecard),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDxScorecardResourceInvalidSQL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Queries that change data are reported by the plan, before anything is created
+			{
+				Config: `
+provider "dx" {}
+
+resource "dx_scorecard" "invalid_sql" {
+  name                           = "Terraform Provider Invalid SQL Scorecard"
+  type                           = "POINTS"
+  entity_filter_type             = "entity_types"
+  entity_filter_type_identifiers = ["service"]
+  evaluation_frequency_hours     = 2
+
+  check_groups = {
+    basics = {
+      name     = "Basics"
+      ordering = 0
+    }
+  }
+
+  checks = {
+    cleanup = {
+      name                      = "Cleanup"
+      scorecard_check_group_key = "basics"
+      ordering                  = 0
+      points                    = 1
+      sql                       = "delete from dx_catalog_entities"
+      output_enabled            = false
+      published                 = true
+    }
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`DELETE statements are not allowed`),
+			},
 		},
 	})
 }
@@ -3,11 +3,14 @@ package scorecard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -186,6 +189,10 @@ func ScorecardSchema() map[string]schema.Attribute {
 				Attributes: CheckSchema(),
 			},
 		},
+		"ignore_external_checks": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether to leave checks that are not declared in `checks` alone, e.g. checks managed with `dx_scorecard_check` resources. They are kept when the scorecard is updated and are not read into `checks`. Defaults to `false`, which removes them.",
+		},
 	}
 }
 
@@ -195,3 +202,87 @@ func (r *ScorecardResource) Schema(ctx context.Context, req resource.SchemaReque
 		Attributes:  ScorecardSchema(),
 	}
 }
+
+func ScorecardCheckSchema() map[string]schema.Attribute {
+	attributes := CheckSchema()
+	attributes["scorecard_id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The ID of the scorecard the check belongs to. Set `ignore_external_checks` on a `dx_scorecard` resource managing the same scorecard, so it leaves this check alone.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["scorecard_level_key"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "The key of the level that this check belongs to (levels scorecards only). This must match the snake cased name of the level, e.g. \"fully_compliant\" for a level named \"Fully Compliant\".",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("scorecard_check_group_key")),
+		},
+	}
+	attributes["scorecard_check_group_key"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "The key of the check group that this check belongs to (points scorecards only). This must match the snake cased name of the check group, e.g. \"ai_readiness\" for a check group named \"AI Readiness\".",
+	}
+	return attributes
+}
+
+func (r *ScorecardCheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single check of a DX Scorecard, without rewriting the rest of the scorecard.",
+		Attributes:  ScorecardCheckSchema(),
+	}
+}
+
+func (r *ExemptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exempts an entity from a check of a DX Scorecard, e.g. for a service that legitimately cannot pass it. Keeping exemptions in code puts them through code review next to the scorecard they apply to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the exemption.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scorecard_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the scorecard. Changing this forces a new exemption.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"check_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the check to exempt the entity from, e.g. from the `checks` of a `dx_scorecard` resource or the `id` of a `dx_scorecard_check` resource. Changing this forces a new exemption.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_identifier": schema.StringAttribute{
+				Required:    true,
+				Description: "The identifier of the exempted entity. Changing this forces a new exemption.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reason": schema.StringAttribute{
+				Required:    true,
+				Description: "Why the entity is exempted from the check.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Optional:    true,
+				Description: "When the exemption expires, as an RFC 3339 timestamp, e.g. `2027-01-31T00:00:00Z`. It must be in the future when it is set. The exemption never expires if not set. Expired exemptions stay in state until they are removed from the configuration.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the exemption was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
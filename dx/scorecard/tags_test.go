@@ -0,0 +1,50 @@
+package scorecard
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestResponseBodyToModelReadsTags verifies that tags are read back from the API, so tags
+// changed outside of Terraform show up as drift.
+func TestResponseBodyToModelReadsTags(t *testing.T) {
+	ctx := context.Background()
+
+	oldState := &ScorecardModel{
+		Tags: []TagModel{{Value: types.StringValue("production")}},
+	}
+	apiResp := &dxapi.APIResponse{
+		Scorecard: dxapi.APIScorecard{
+			Id:   "scorecard-1",
+			Tags: []dxapi.APITag{{Value: "production"}, {Value: "added-in-ui"}},
+		},
+	}
+
+	state := &ScorecardModel{}
+	responseBodyToModel(ctx, apiResp, state, oldState)
+
+	if len(state.Tags) != 2 || state.Tags[0].Value.ValueString() != "production" || state.Tags[1].Value.ValueString() != "added-in-ui" {
+		t.Errorf("Expected the tags returned by the API, got %v", state.Tags)
+	}
+}
+
+func TestResponseBodyToModelKeepsEmptyTags(t *testing.T) {
+	ctx := context.Background()
+	apiResp := &dxapi.APIResponse{Scorecard: dxapi.APIScorecard{Id: "scorecard-1"}}
+
+	state := &ScorecardModel{}
+	responseBodyToModel(ctx, apiResp, state, &ScorecardModel{Tags: []TagModel{}})
+	if state.Tags == nil || len(state.Tags) != 0 {
+		t.Errorf("Expected an empty tags set to stay empty, got %v", state.Tags)
+	}
+
+	state = &ScorecardModel{}
+	responseBodyToModel(ctx, apiResp, state, &ScorecardModel{})
+	if state.Tags != nil {
+		t.Errorf("Expected unset tags to stay null, got %v", state.Tags)
+	}
+}
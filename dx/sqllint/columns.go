@@ -0,0 +1,104 @@
+package sqllint
+
+import (
+	"fmt"
+
+	pg "github.com/pganalyze/pg_query_go/v6"
+)
+
+// unnamedColumn is the name Postgres gives a column it cannot name from its expression.
+const unnamedColumn = "?column?"
+
+// Column names are chosen by how clearly the expression names the column, following
+// FigureColname in the Postgres parser: a cast names a column after its type unless the
+// expression it casts names the column clearly.
+const (
+	nameNone = iota
+	nameWeak
+	nameStrong
+)
+
+// outputColumns returns the names of the columns the statement returns. known is false when
+// they depend on the tables queried, e.g. because the statement selects `*`.
+func outputColumns(statement *pg.SelectStmt) ([]string, bool) {
+	// The first query of a UNION, INTERSECT or EXCEPT names the columns
+	for statement.GetOp() != pg.SetOperation_SETOP_NONE && statement.GetLarg() != nil {
+		statement = statement.GetLarg()
+	}
+
+	var columns []string
+	if values := statement.GetValuesLists(); len(values) > 0 {
+		for i := range values[0].GetList().GetItems() {
+			columns = append(columns, fmt.Sprintf("column%d", i+1))
+		}
+		return columns, true
+	}
+
+	for _, node := range statement.GetTargetList() {
+		target := node.GetResTarget()
+		if target.GetName() != "" {
+			columns = append(columns, target.GetName())
+			continue
+		}
+		name, _, known := columnName(target.GetVal())
+		if !known {
+			return nil, false
+		}
+		columns = append(columns, name)
+	}
+	return columns, true
+}
+
+// columnName returns the name Postgres gives a select list item without an alias, and how
+// clearly the expression names it. known is false for `*` and `table.*`.
+func columnName(node *pg.Node) (name string, strength int, known bool) {
+	switch {
+	case node.GetColumnRef() != nil:
+		fields := node.GetColumnRef().GetFields()
+		if len(fields) == 0 || fields[len(fields)-1].GetAStar() != nil {
+			return "", nameNone, false
+		}
+		return fields[len(fields)-1].GetString_().GetSval(), nameStrong, true
+	case node.GetAIndirection() != nil:
+		indirection := node.GetAIndirection().GetIndirection()
+		for i := len(indirection) - 1; i >= 0; i-- {
+			if indirection[i].GetAStar() != nil {
+				return "", nameNone, false
+			}
+			if s := indirection[i].GetString_(); s != nil {
+				return s.GetSval(), nameStrong, true
+			}
+		}
+		return columnName(node.GetAIndirection().GetArg())
+	case node.GetFuncCall() != nil:
+		names := node.GetFuncCall().GetFuncname()
+		return names[len(names)-1].GetString_().GetSval(), nameStrong, true
+	case node.GetTypeCast() != nil:
+		name, strength, known := columnName(node.GetTypeCast().GetArg())
+		if !known || strength == nameStrong {
+			return name, strength, known
+		}
+		if names := node.GetTypeCast().GetTypeName().GetNames(); len(names) > 0 {
+			return names[len(names)-1].GetString_().GetSval(), nameWeak, true
+		}
+		return name, strength, known
+	case node.GetCaseExpr() != nil:
+		return "case", nameWeak, true
+	case node.GetCoalesceExpr() != nil:
+		return "coalesce", nameStrong, true
+	case node.GetAArrayExpr() != nil:
+		return "array", nameStrong, true
+	case node.GetRowExpr() != nil:
+		return "row", nameStrong, true
+	case node.GetSubLink() != nil:
+		switch node.GetSubLink().GetSubLinkType() {
+		case pg.SubLinkType_EXISTS_SUBLINK:
+			return "exists", nameStrong, true
+		case pg.SubLinkType_ARRAY_SUBLINK:
+			return "array", nameStrong, true
+		}
+	}
+	// Anything else may still get a name from Postgres, but it is reported as unnamed, which
+	// only ever leads to warnings
+	return unnamedColumn, nameNone, true
+}
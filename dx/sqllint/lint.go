@@ -0,0 +1,295 @@
+// Package sqllint checks the SQL queries in scorecards and entity types at plan time. DX only
+// runs these queries when it evaluates them, often hours after an apply, so mistakes such as a
+// missing `status` column would otherwise go unnoticed.
+//
+// Queries are parsed with libpg_query, the parser of Postgres itself, compiled to WebAssembly so
+// the provider still builds without cgo. The placeholders DX substitutes, such as
+// `$entity_identifier`, are not Postgres syntax, so they are replaced with a positional
+// parameter before the query is parsed.
+package sqllint
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	pg "github.com/pganalyze/pg_query_go/v6"
+	pgquery "github.com/wasilibs/go-pgquery"
+	pgparser "github.com/wasilibs/go-pgquery/parser"
+)
+
+// Rules describe what a query must look like where DX runs it.
+type Rules struct {
+	// RequiredColumns are the columns the query must return, lower case.
+	RequiredColumns []string
+	// Placeholders are the named placeholders DX substitutes, without the dollar sign.
+	Placeholders []string
+}
+
+// EntityPlaceholders are the placeholders DX substitutes in queries evaluated for each entity.
+var EntityPlaceholders = []string{"entity_identifier"}
+
+var (
+	// CheckRules apply to the `sql` of scorecard checks.
+	CheckRules = Rules{RequiredColumns: []string{"status"}, Placeholders: EntityPlaceholders}
+	// CheckOutputRules apply to the `sql` of scorecard checks with `output_enabled`.
+	CheckOutputRules = Rules{RequiredColumns: []string{"status", "output"}, Placeholders: EntityPlaceholders}
+	// FilterRules apply to the `filter_sql` of scorecard checks.
+	FilterRules = Rules{Placeholders: EntityPlaceholders}
+	// EntityFilterRules apply to the `entity_filter_sql` of scorecards, which select entities
+	// rather than run for one.
+	EntityFilterRules = Rules{}
+	// ComputedPropertyRules apply to the `sql` of computed entity type properties.
+	ComputedPropertyRules = Rules{Placeholders: EntityPlaceholders}
+)
+
+// Severity is how serious a problem is.
+type Severity int
+
+const (
+	// SeverityError is a query DX will reject or fail to evaluate.
+	SeverityError Severity = iota
+	// SeverityWarning is a query that is likely to be a mistake.
+	SeverityWarning
+)
+
+// Problem is an issue found in a query.
+type Problem struct {
+	Severity Severity
+	Message  string
+}
+
+// placeholder is a `$name` placeholder or `$1` positional parameter in a query.
+type placeholder struct {
+	name       string
+	start, end int
+	positional bool
+}
+
+// Lint checks the query against the rules and returns the problems found, errors first.
+func Lint(sql string, rules Rules) []Problem {
+	scan, err := pgquery.Scan(sql)
+	if err != nil {
+		return []Problem{syntaxError(sql, err)}
+	}
+	tokens := significantTokens(scan.GetTokens())
+	placeholders := findPlaceholders(sql, tokens)
+
+	tree, err := pgquery.Parse(substitutePlaceholders(sql, placeholders))
+	if err != nil {
+		return []Problem{syntaxError(sql, err)}
+	}
+	switch len(tree.GetStmts()) {
+	case 0:
+		return []Problem{errorf("The query is empty.")}
+	case 1:
+	default:
+		return []Problem{errorf("The query has %d statements. DX runs a single SELECT query, so remove the extra statements.", len(tree.GetStmts()))}
+	}
+
+	statement := tree.GetStmts()[0].GetStmt().GetSelectStmt()
+	if statement == nil {
+		return []Problem{errorf("%s statements are not allowed. DX only runs read-only SELECT queries.", firstKeyword(sql, tokens))}
+	}
+	if p := checkReadOnly(sql, statement); p != nil {
+		return []Problem{*p}
+	}
+	problems := checkColumns(statement, rules)
+	problems = append(problems, checkPlaceholders(sql, placeholders, rules)...)
+	return problems
+}
+
+func errorf(format string, args ...interface{}) Problem {
+	return Problem{Severity: SeverityError, Message: fmt.Sprintf(format, args...)}
+}
+
+func warningf(format string, args ...interface{}) Problem {
+	return Problem{Severity: SeverityWarning, Message: fmt.Sprintf(format, args...)}
+}
+
+// syntaxError reports an error from the Postgres scanner or parser, on the line it points at.
+func syntaxError(sql string, err error) Problem {
+	var pgErr *pgparser.Error
+	if errors.As(err, &pgErr) && pgErr.Cursorpos > 0 {
+		return errorf("The query has a syntax error on line %d: %s.", lineAt(sql, pgErr.Cursorpos-1), pgErr.Message)
+	}
+	return errorf("The query has a syntax error: %s.", err)
+}
+
+// lineAt returns the line of the byte offset in sql, counting from 1.
+func lineAt(sql string, offset int) int {
+	offset = min(max(offset, 0), len(sql))
+	return strings.Count(sql[:offset], "\n") + 1
+}
+
+// significantTokens drops the comments from the scanned tokens.
+func significantTokens(tokens []*pg.ScanToken) []*pg.ScanToken {
+	significant := make([]*pg.ScanToken, 0, len(tokens))
+	for _, tok := range tokens {
+		if tok.GetToken() != pg.Token_SQL_COMMENT && tok.GetToken() != pg.Token_C_COMMENT {
+			significant = append(significant, tok)
+		}
+	}
+	return significant
+}
+
+// findPlaceholders returns the placeholders in the query. Postgres scans `$entity_identifier`
+// as a `$` directly followed by an identifier, and `$1` as a positional parameter.
+func findPlaceholders(sql string, tokens []*pg.ScanToken) []placeholder {
+	var placeholders []placeholder
+	for i, tok := range tokens {
+		switch {
+		case tok.GetToken() == pg.Token_PARAM:
+			placeholders = append(placeholders, placeholder{name: sql[tok.GetStart():tok.GetEnd()], start: int(tok.GetStart()), end: int(tok.GetEnd()), positional: true})
+		case tok.GetToken() == pg.Token_ASCII_36 && i+1 < len(tokens) && tokens[i+1].GetStart() == tok.GetEnd() && isIdentStart(sql[tok.GetEnd()]):
+			end := int(tokens[i+1].GetEnd())
+			placeholders = append(placeholders, placeholder{name: strings.ToLower(sql[tok.GetStart():end]), start: int(tok.GetStart()), end: end})
+		}
+	}
+	return placeholders
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+// substitutePlaceholders replaces the named placeholders with `$1`, padded with spaces so the
+// offsets in parser errors still point into the original query.
+func substitutePlaceholders(sql string, placeholders []placeholder) string {
+	var b strings.Builder
+	last := 0
+	for _, p := range placeholders {
+		if p.positional {
+			continue
+		}
+		b.WriteString(sql[last:p.start])
+		b.WriteString("$1")
+		b.WriteString(strings.Repeat(" ", p.end-p.start-2))
+		last = p.end
+	}
+	b.WriteString(sql[last:])
+	return b.String()
+}
+
+// firstKeyword returns the keyword a statement starts with, upper case.
+func firstKeyword(sql string, tokens []*pg.ScanToken) string {
+	for _, tok := range tokens {
+		if tok.GetToken() != pg.Token_ASCII_40 {
+			return strings.ToUpper(sql[tok.GetStart():tok.GetEnd()])
+		}
+	}
+	return "These"
+}
+
+// checkReadOnly reports data-modifying statements in common table expressions and SELECT INTO,
+// which make a SELECT query change data.
+func checkReadOnly(sql string, statement *pg.SelectStmt) *Problem {
+	for _, node := range statement.GetWithClause().GetCtes() {
+		cte := node.GetCommonTableExpr()
+		query := cte.GetCtequery()
+		var keyword string
+		switch {
+		case query.GetInsertStmt() != nil:
+			keyword = "INSERT"
+		case query.GetUpdateStmt() != nil:
+			keyword = "UPDATE"
+		case query.GetDeleteStmt() != nil:
+			keyword = "DELETE"
+		case query.GetMergeStmt() != nil:
+			keyword = "MERGE"
+		default:
+			continue
+		}
+		p := errorf("%s statements are not allowed, including in WITH queries (line %d). DX only runs read-only SELECT queries.", keyword, lineAt(sql, int(cte.GetLocation())))
+		return &p
+	}
+
+	for s := statement; s != nil; s = s.GetLarg() {
+		if into := s.GetIntoClause(); into != nil {
+			p := errorf("SELECT INTO creates a table (line %d), which is not allowed. DX only runs read-only SELECT queries.", lineAt(sql, int(into.GetRel().GetLocation())))
+			return &p
+		}
+	}
+	return nil
+}
+
+// checkColumns reports required columns that the query does not return. A missing column is
+// only an error when the name of every column is known, otherwise an unnamed expression may
+// still be the required column.
+func checkColumns(statement *pg.SelectStmt, rules Rules) []Problem {
+	if len(rules.RequiredColumns) == 0 {
+		return nil
+	}
+	columns, known := outputColumns(statement)
+	if !known {
+		return nil
+	}
+
+	returned := map[string]bool{}
+	for _, column := range columns {
+		returned[column] = true
+	}
+	var problems []Problem
+	for _, required := range rules.RequiredColumns {
+		if returned[required] {
+			continue
+		}
+		if returned[unnamedColumn] {
+			problems = append(problems, warningf(
+				"The query does not appear to return a column named %q. It returns %s. Name the column with AS, e.g. `select 'PASS' as status`.",
+				required, quoteColumns(columns)))
+			continue
+		}
+		problems = append(problems, errorf(
+			"The query must return a column named %q, but it returns %s. Name the column with AS, e.g. `select 'PASS' as status`.",
+			required, quoteColumns(columns)))
+	}
+	return problems
+}
+
+func quoteColumns(columns []string) string {
+	if len(columns) == 0 {
+		return "no columns"
+	}
+	quoted := make([]string, 0, len(columns))
+	for _, column := range columns {
+		quoted = append(quoted, fmt.Sprintf("%q", column))
+	}
+	if len(quoted) == 1 {
+		return "only " + quoted[0]
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// checkPlaceholders reports placeholders DX does not substitute. These are warnings rather than
+// errors, so placeholders DX adds before the provider knows about them can still be used.
+func checkPlaceholders(sql string, placeholders []placeholder, rules Rules) []Problem {
+	declared := map[string]bool{}
+	for _, name := range rules.Placeholders {
+		declared["$"+name] = true
+	}
+
+	reported := map[string]bool{}
+	var problems []Problem
+	for _, p := range placeholders {
+		if declared[p.name] || reported[p.name] {
+			continue
+		}
+		reported[p.name] = true
+		problems = append(problems, warningf("The query references %s on line %d, which DX does not substitute here. %s", p.name, lineAt(sql, p.start), placeholderHint(rules)))
+	}
+	return problems
+}
+
+func placeholderHint(rules Rules) string {
+	if len(rules.Placeholders) == 0 {
+		return "This query is not evaluated for a single entity, so it cannot use placeholders."
+	}
+	names := make([]string, 0, len(rules.Placeholders))
+	for _, name := range rules.Placeholders {
+		names = append(names, "$"+name)
+	}
+	sort.Strings(names)
+	return "Available placeholders: " + strings.Join(names, ", ") + "."
+}
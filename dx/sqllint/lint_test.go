@@ -0,0 +1,92 @@
+package sqllint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintAcceptsValidQueries(t *testing.T) {
+	queries := map[string]struct {
+		sql   string
+		rules Rules
+	}{
+		"literal status": {"select 'PASS' as status", CheckRules},
+		"trailing semicolon and comments": {`
+			-- Every service passes
+			select 'PASS' as status; /* done /* nested */ */`, CheckRules},
+		"implicit aliases and with": {`
+			with random_number as (
+			  select ROUND(RANDOM() * 10) as value
+			)
+			select case
+			    when value >= 7 then 'PASS'
+			    else 'FAIL'
+			  end as status,
+			  value output
+			from random_number`, CheckOutputRules},
+		"qualified columns":           {"select c.status, c.output from checks c where c.identifier = $entity_identifier", CheckOutputRules},
+		"dollar quoted strings":       {"select $tag$it's $1 'quoted'$tag$ as status, $$x$$::text as output", CheckOutputRules},
+		"column named like a keyword": {"select coalesce(update, 'FAIL') as status from (select delete, update from t) x", CheckRules},
+		"select star is not checked":  {"select * from check_results where identifier = $entity_identifier", CheckOutputRules},
+		"function column name":        {"SELECT COUNT(*) FROM portal_entities WHERE identifier = $entity_identifier", ComputedPropertyRules},
+		"union takes the first names": {"select 'PASS' as status union all select 'FAIL'", CheckRules},
+		"alias after case":            {"select case when x then 'PASS' else 'FAIL' end status from t", CheckRules},
+		"alias after true":            {"select true status", CheckRules},
+		"alias after null":            {"select null output, 'PASS' status", CheckOutputRules},
+		"alias after is not null":     {"select 'PASS' status, x is not null output from t", CheckOutputRules},
+		"window function":             {"select case when rank() over (partition by team order by score desc) = 1 then 'PASS' else 'FAIL' end as status from scores", CheckRules},
+		"quoted identifiers":          {`select "Status" as status, "select" as output from "Check Results"`, CheckOutputRules},
+		"cast names the column":       {"select 'PASS'::text as status, count(*)::text as output from t", CheckOutputRules},
+		"case cast":                   {"select (case when true then 'PASS' end)::text status", CheckRules},
+		"placeholder in a string":     {"select 'PASS' as status where '$entity_type' <> ''", CheckRules},
+		"placeholder cast":            {"select 'PASS' as status from entities where identifier = $entity_identifier::text", CheckRules},
+		"values":                      {"select column1 as status from (values ('PASS')) v", CheckRules},
+	}
+
+	for name, query := range queries {
+		t.Run(name, func(t *testing.T) {
+			if problems := Lint(query.sql, query.rules); len(problems) != 0 {
+				t.Errorf("Expected no problems, got %+v", problems)
+			}
+		})
+	}
+}
+
+func TestLintReportsProblems(t *testing.T) {
+	queries := map[string]struct {
+		sql      string
+		rules    Rules
+		severity Severity
+		message  string
+	}{
+		"empty":                {"  -- nothing\n", CheckRules, SeverityError, "The query is empty."},
+		"unterminated string":  {"select 'PASS as status", CheckRules, SeverityError, "line 1: unterminated quoted string"},
+		"unbalanced":           {"select count(* as status", CheckRules, SeverityError, `line 1: syntax error at or near "as"`},
+		"syntax error line":    {"select 'PASS' as status\nfrom entities\nwhere identifier = = $entity_identifier", CheckRules, SeverityError, `line 3: syntax error at or near "="`},
+		"multiple statements":  {"select 'PASS' as status; select 1", CheckRules, SeverityError, "2 statements"},
+		"delete":               {"DELETE FROM entities", FilterRules, SeverityError, "DELETE statements are not allowed"},
+		"drop":                 {"drop table entities", FilterRules, SeverityError, "DROP statements are not allowed"},
+		"modifying cte":        {"with gone as (delete from entities returning *) select 'PASS' as status", CheckRules, SeverityError, "including in WITH queries (line 1)"},
+		"select into":          {"select 'PASS' as status into results", CheckRules, SeverityError, "SELECT INTO creates a table"},
+		"not a query":          {"explain select 1", FilterRules, SeverityError, "EXPLAIN statements are not allowed"},
+		"missing status":       {"select 'PASS' as state", CheckRules, SeverityError, `must return a column named "status", but it returns only "state"`},
+		"missing output":       {"select 'PASS' as status, 1 as value", CheckOutputRules, SeverityError, `must return a column named "output", but it returns "status", "value"`},
+		"unnamed column":       {"select 'PASS' as status, 1", CheckOutputRules, SeverityWarning, `does not appear to return a column named "output". It returns "status", "?column?"`},
+		"quoted column case":   {`select 'PASS' as "Status"`, CheckRules, SeverityError, `returns only "Status"`},
+		"undeclared":           {"select 'PASS' as status where $entity_type = 'service'", CheckRules, SeverityWarning, "references $entity_type on line 1"},
+		"positional parameter": {"select 'PASS' as status where id = $1", CheckRules, SeverityWarning, "references $1"},
+		"no placeholders":      {"select identifier from entities where identifier = $entity_identifier", EntityFilterRules, SeverityWarning, "cannot use placeholders"},
+	}
+
+	for name, query := range queries {
+		t.Run(name, func(t *testing.T) {
+			problems := Lint(query.sql, query.rules)
+			if len(problems) != 1 {
+				t.Fatalf("Expected 1 problem, got %+v", problems)
+			}
+			if problems[0].Severity != query.severity || !strings.Contains(problems[0].Message, query.message) {
+				t.Errorf("Expected a problem containing %q with severity %d, got %+v", query.message, query.severity, problems[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package sqllint
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AddDiagnostics lints the query and reports the problems against the attribute holding it.
+func AddDiagnostics(diags *diag.Diagnostics, attributePath path.Path, sql string, rules Rules) {
+	for _, problem := range Lint(sql, rules) {
+		if problem.Severity == SeverityError {
+			diags.AddAttributeError(attributePath, "Invalid SQL", problem.Message)
+		} else {
+			diags.AddAttributeWarning(attributePath, "Possible SQL problem", problem.Message)
+		}
+	}
+}
+
+// Query returns a validator that lints string attributes holding a query.
+func Query(rules Rules) validator.String {
+	return queryValidator{rules: rules}
+}
+
+type queryValidator struct {
+	rules Rules
+}
+
+func (v queryValidator) Description(ctx context.Context) string {
+	return "value must be a single read-only SQL query"
+}
+
+func (v queryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v queryValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	AddDiagnostics(&resp.Diagnostics, req.Path, req.ConfigValue.ValueString(), v.rules)
+}
@@ -0,0 +1,122 @@
+package team
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &TeamDataSource{}
+	_ datasource.DataSourceWithConfigure = &TeamDataSource{}
+)
+
+func NewTeamDataSource() datasource.DataSource {
+	return &TeamDataSource{}
+}
+
+// TeamDataSource defines the data source implementation.
+type TeamDataSource struct {
+	client *dxapi.Client
+}
+
+func (d *TeamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (d *TeamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := teamSchema()
+	attributes["id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The DX ID of the team to look up. Exactly one of 'id' and 'name' must be set.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The exact name of the team to look up. Exactly one of 'id' and 'name' must be set.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reads a DX team by ID or name. Use this to find the team IDs that dx_entity owners refer to.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *TeamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dxapi.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dxapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading team data source")
+
+	var config TeamModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Teams are looked up in the full listing, which is also needed to find the team's children
+	teams, err := d.client.ListTeams(ctx, &dxapi.PageOptions{PageSize: dxapi.MaxPageSize})
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing teams", err.Error())
+		return
+	}
+
+	var matches []dxapi.APITeam
+	for _, team := range teams {
+		if (!config.Id.IsNull() && team.Id == config.Id.ValueString()) || (!config.Name.IsNull() && team.Name == config.Name.ValueString()) {
+			matches = append(matches, team)
+		}
+	}
+
+	switch {
+	case len(matches) == 0 && !config.Id.IsNull():
+		resp.Diagnostics.AddError("Team not found", fmt.Sprintf("No team with ID %q exists.", config.Id.ValueString()))
+		return
+	case len(matches) == 0:
+		resp.Diagnostics.AddError("Team not found", fmt.Sprintf("No team named %q exists.", config.Name.ValueString()))
+		return
+	case len(matches) > 1:
+		resp.Diagnostics.AddError("More than one team found", fmt.Sprintf("%d teams are named %q. Look the team up by 'id' instead.", len(matches), config.Name.ValueString()))
+		return
+	}
+
+	var state TeamModel
+	teamToModel(matches[0], teams, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,119 @@
+package team
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &TeamsDataSource{}
+	_ datasource.DataSourceWithConfigure = &TeamsDataSource{}
+)
+
+func NewTeamsDataSource() datasource.DataSource {
+	return &TeamsDataSource{}
+}
+
+type TeamsDataSource struct {
+	client *dxapi.Client
+}
+
+type TeamsDataSourceModel struct {
+	ParentId types.String `tfsdk:"parent_id"`
+	TopLevel types.Bool   `tfsdk:"top_level"`
+	Teams    []TeamModel  `tfsdk:"teams"`
+}
+
+func (d *TeamsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+func (d *TeamsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists DX teams with their place in the team hierarchy, optionally only the children of one team.",
+		Attributes: map[string]schema.Attribute{
+			"parent_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the teams whose parent is the team with this ID.",
+			},
+			"top_level": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return the teams that have no parent team.",
+			},
+			"teams": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The teams matching every given filter.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: teamSchema(),
+				},
+			},
+		},
+	}
+}
+
+func (d *TeamsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dxapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dxapi.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The API client was not configured. This is a bug in the provider.")
+		return
+	}
+}
+
+func (d *TeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading teams data source")
+
+	var config TeamsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teams, err := d.client.ListTeams(ctx, &dxapi.PageOptions{PageSize: dxapi.MaxPageSize})
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing teams", err.Error())
+		return
+	}
+
+	state := TeamsDataSourceModel{
+		ParentId: config.ParentId,
+		TopLevel: config.TopLevel,
+		Teams:    []TeamModel{},
+	}
+
+	for _, team := range teams {
+		var teamModel TeamModel
+		teamToModel(team, teams, &teamModel)
+
+		if !config.ParentId.IsNull() && teamModel.ParentId.ValueString() != config.ParentId.ValueString() {
+			continue
+		}
+		if config.TopLevel.ValueBool() && !teamModel.ParentId.IsNull() {
+			continue
+		}
+		state.Teams = append(state.Teams, teamModel)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
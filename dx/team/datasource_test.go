@@ -0,0 +1,56 @@
+package team_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-dx/internal/acctest"
+)
+
+func TestAccDxTeamDataSources(t *testing.T) {
+	// Asserts on the teams the fake is seeded with
+	acctest.SkipWithRealAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "dx" {}
+
+data "dx_team" "engineering" {
+  name = "Engineering"
+}
+
+data "dx_team" "by_id" {
+  id = data.dx_team.engineering.child_team_ids[0]
+}
+
+data "dx_teams" "children" {
+  parent_id = data.dx_team.engineering.id
+}
+
+data "dx_teams" "top_level" {
+  top_level = true
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.dx_team.engineering", "id"),
+					resource.TestCheckNoResourceAttr("data.dx_team.engineering", "parent_id"),
+					resource.TestCheckResourceAttr("data.dx_team.engineering", "child_team_ids.#", "2"),
+
+					resource.TestCheckResourceAttr("data.dx_team.by_id", "name", "Developer Experience"),
+					resource.TestCheckResourceAttrPair("data.dx_team.by_id", "parent_id", "data.dx_team.engineering", "id"),
+					resource.TestCheckResourceAttr("data.dx_team.by_id", "child_team_ids.#", "0"),
+
+					resource.TestCheckResourceAttr("data.dx_teams.children", "teams.#", "2"),
+					resource.TestCheckResourceAttr("data.dx_teams.children", "teams.1.name", "Payments"),
+					resource.TestCheckResourceAttr("data.dx_teams.top_level", "teams.#", "1"),
+					resource.TestCheckResourceAttr("data.dx_teams.top_level", "teams.0.name", "Engineering"),
+				),
+			},
+		},
+	})
+}
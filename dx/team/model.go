@@ -0,0 +1,55 @@
+package team
+
+import (
+	"terraform-provider-dx/dx"
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TeamModel struct {
+	Id           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	ParentId     types.String   `tfsdk:"parent_id"`
+	ChildTeamIds []types.String `tfsdk:"child_team_ids"`
+}
+
+// teamSchema describes a team with every attribute computed. The dx_team data source makes its
+// lookup attributes optional.
+func teamSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The DX ID of the team, as used in 'owner_team_ids' of dx_entity.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the team.",
+		},
+		"parent_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the team's parent team. Null for top-level teams.",
+		},
+		"child_team_ids": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "The IDs of the teams whose parent is this team.",
+		},
+	}
+}
+
+// teamToModel maps a team to the model. teams is the full team listing, which the team's children
+// are found in.
+func teamToModel(team dxapi.APITeam, teams []dxapi.APITeam, model *TeamModel) {
+	model.Id = types.StringValue(team.Id)
+	model.Name = types.StringValue(team.Name)
+	model.ParentId = dx.StringOrNullConvertEmpty(team.ParentId)
+
+	model.ChildTeamIds = []types.String{}
+	for _, child := range teams {
+		if child.ParentId != nil && *child.ParentId == team.Id {
+			model.ChildTeamIds = append(model.ChildTeamIds, types.StringValue(child.Id))
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package user_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-dx/internal/acctest"
+)
+
+func TestAccDxUserDataSources(t *testing.T) {
+	// Asserts on the users the fake is seeded with
+	acctest.SkipWithRealAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "dx" {}
+
+data "dx_user" "ada" {
+  email = "Ada@example.com"
+}
+
+data "dx_users" "all" {}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.dx_user.ada", "id"),
+					resource.TestCheckResourceAttr("data.dx_user.ada", "name", "Ada Lovelace"),
+					resource.TestCheckResourceAttr("data.dx_user.ada", "email", "Ada@example.com"),
+					resource.TestCheckResourceAttr("data.dx_users.all", "users.#", "2"),
+					resource.TestCheckResourceAttrPair("data.dx_users.all", "users.0.id", "data.dx_user.ada", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,40 @@
+package user
+
+import (
+	"terraform-provider-dx/dx"
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type UserModel struct {
+	Id    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+}
+
+// userSchema describes a user with every attribute computed. The dx_user data source makes
+// 'email' required.
+func userSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The DX ID of the user, as used in 'owner_user_ids' of dx_entity.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the user.",
+		},
+		"email": schema.StringAttribute{
+			Computed:    true,
+			Description: "The email address of the user.",
+		},
+	}
+}
+
+func userToModel(user dxapi.APIUser, model *UserModel) {
+	model.Id = types.StringValue(user.Id)
+	model.Name = dx.StringOrNullConvertEmpty(&user.Name)
+	model.Email = types.StringValue(user.Email)
+}
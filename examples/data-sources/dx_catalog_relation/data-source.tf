@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    dx = {
+      source  = "registry.terraform.io/get-dx/dx"
+      version = "~> 0.11.0"
+    }
+  }
+}
+
+provider "dx" {}
+
+# Look up a relation definition managed outside this configuration
+data "dx_catalog_relation" "service_dependencies" {
+  identifier = "service_depends_on_service"
+}
+
+output "service_dependencies_inverse_type" {
+  value = data.dx_catalog_relation.service_dependencies.inverse_type
+}
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    dx = {
+      source  = "registry.terraform.io/get-dx/dx"
+      version = "~> 0.11.0"
+    }
+  }
+}
+
+provider "dx" {}
+
+# List every relation definition whose source side is a service
+data "dx_catalog_relations" "from_services" {
+  source_entity_type_identifier = "service"
+}
+
+output "service_relation_identifiers" {
+  value = [for relation in data.dx_catalog_relations.from_services.relations : relation.identifier]
+}
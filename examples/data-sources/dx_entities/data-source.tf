@@ -35,7 +35,13 @@ output "payment_service_names" {
   value       = [for e in data.dx_entities.payment_services.entities : e.name]
 }
 
-# Example 3: Access entity properties
+# Example 3: Only read the first few matching entities
+data "dx_entities" "some_services" {
+  type  = "service"
+  limit = 10
+}
+
+# Example 4: Access entity properties
 # The properties field is JSON-encoded, use jsondecode() to access values
 output "service_tiers" {
   description = "Tier property for each service"
@@ -44,3 +50,9 @@ output "service_tiers" {
     e.properties != null ? jsondecode(e.properties)["tier"] : null
   ]
 }
+
+# Example 5: Access typed properties without decoding JSON
+output "service_tiers_typed" {
+  description = "Tier property for each service"
+  value       = { for e in data.dx_entities.all_services.entities : e.identifier => lookup(e.text_properties, "tier", null) }
+}
@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    dx = {
+      source  = "registry.terraform.io/get-dx/dx"
+      version = "~> 0.11.0"
+    }
+  }
+}
+
+provider "dx" {}
+
+# Look up an entity type to discover the properties and aliases its entities support
+data "dx_entity_type" "service" {
+  identifier = "service"
+}
+
+output "service_property_types" {
+  description = "The type of each property, keyed by property identifier"
+  value       = { for identifier, property in data.dx_entity_type.service.properties : identifier => property.type }
+}
+
+output "service_alias_types" {
+  description = "The alias types enabled for services"
+  value       = [for alias_type, enabled in data.dx_entity_type.service.aliases : alias_type if enabled]
+}
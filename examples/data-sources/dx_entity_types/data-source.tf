@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    dx = {
+      source  = "registry.terraform.io/get-dx/dx"
+      version = "~> 0.11.0"
+    }
+  }
+}
+
+provider "dx" {}
+
+# List every entity type in the catalog
+data "dx_entity_types" "all" {}
+
+output "entity_type_identifiers" {
+  value = [for entity_type in data.dx_entity_types.all.entity_types : entity_type.identifier]
+}
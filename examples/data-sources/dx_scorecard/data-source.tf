@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    dx = {
+      source  = "registry.terraform.io/get-dx/dx"
+      version = "~> 0.11.0"
+    }
+  }
+}
+
+provider "dx" {}
+
+# Example 1: Look up a scorecard by ID
+data "dx_scorecard" "production_readiness" {
+  id = "2xk3l9m4n5p6"
+}
+
+# Example 2: Look up a scorecard by name, e.g. one managed by another team
+data "dx_scorecard" "security" {
+  name = "Security Baseline"
+}
+
+# Levels, check groups and checks are keyed by their snake cased names
+output "security_gold_level_id" {
+  value = data.dx_scorecard.security.levels["gold"].id
+}
+
+output "security_check_ids" {
+  value = { for key, check in data.dx_scorecard.security.checks : key => check.id }
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    dx = {
+      source  = "registry.terraform.io/get-dx/dx"
+      version = "~> 0.11.0"
+    }
+  }
+}
+
+provider "dx" {}
+
+variable "production_readiness_scorecard_id" {
+  type = string
+}
+
+# Example 1: List the active exemptions of a scorecard, including those granted in the DX UI
+data "dx_scorecard_check_exemptions" "production_readiness" {
+  scorecard_id = var.production_readiness_scorecard_id
+}
+
+output "exempted_entities" {
+  value = {
+    for exemption in data.dx_scorecard_check_exemptions.production_readiness.exemptions :
+    exemption.entity_identifier => exemption.reason...
+  }
+}
+
+# Example 2: List the active exemptions of one service across every scorecard
+data "dx_scorecard_check_exemptions" "payments" {
+  entity_identifier = "payments-api"
+}
@@ -0,0 +1,50 @@
+terraform {
+  required_providers {
+    dx = {
+      source  = "registry.terraform.io/get-dx/dx"
+      version = "~> 0.11.0"
+    }
+  }
+}
+
+provider "dx" {}
+
+# Preview a new check against the services a scorecard assesses, so the plan of a
+# pull request shows how many services it would fail
+data "dx_scorecard_check_preview" "has_owner" {
+  scorecard_id = dx_scorecard.production_readiness.id
+  sample_size  = 50
+
+  check = {
+    sql = <<-EOT
+      select case
+          when count(*) > 0 then 'PASS'
+          else 'FAIL'
+        end as status
+      from dx_catalog_entity_owners
+      where entity_identifier = $entity_identifier
+    EOT
+  }
+}
+
+output "has_owner_failures" {
+  value = [
+    for result in data.dx_scorecard_check_preview.has_owner.results :
+    result.entity_name if result.status == "FAIL"
+  ]
+}
+
+# Preview an existing check of a scorecard against every service
+data "dx_scorecard_check_preview" "existing" {
+  entity_filter_type_identifiers = ["service"]
+  check                          = dx_scorecard.production_readiness.checks["has_runbook"]
+}
+
+output "has_runbook_summary" {
+  value = {
+    pass     = data.dx_scorecard_check_preview.existing.pass_count
+    warn     = data.dx_scorecard_check_preview.existing.warn_count
+    fail     = data.dx_scorecard_check_preview.existing.fail_count
+    excluded = data.dx_scorecard_check_preview.existing.excluded_count
+  }
+}
@@ -0,0 +1,52 @@
+terraform {
+  required_providers {
+    dx = {
+      source  = "registry.terraform.io/get-dx/dx"
+      version = "~> 0.11.0"
+    }
+  }
+}
+
+provider "dx" {}
+
+variable "production_readiness_scorecard_id" {
+  type = string
+}
+
+# Example 1: Only deploy a service once it reaches the Silver level
+data "dx_scorecard" "production_readiness" {
+  id = var.production_readiness_scorecard_id
+}
+
+data "dx_scorecard_results" "payments" {
+  scorecard_id      = data.dx_scorecard.production_readiness.id
+  entity_identifier = "payments-api"
+}
+
+resource "terraform_data" "deploy" {
+  input = "payments-api"
+
+  lifecycle {
+    precondition {
+      condition = (
+        length(data.dx_scorecard_results.payments.results) == 1 &&
+        coalesce(data.dx_scorecard_results.payments.results[0].level_rank, 0) >= data.dx_scorecard.production_readiness.levels["silver"].rank
+      )
+      error_message = "payments-api must reach the Silver level of the production readiness scorecard before it is deployed."
+    }
+  }
+}
+
+# Example 2: Count the services at each level, e.g. for a dashboard
+data "dx_scorecard_results" "all" {
+  scorecard_id = data.dx_scorecard.production_readiness.id
+}
+
+output "services_per_level" {
+  value = {
+    for level, services in {
+      for result in data.dx_scorecard_results.all.results :
+      coalesce(result.level_name, data.dx_scorecard.production_readiness.empty_level_label) => result.entity_identifier...
+    } : level => length(services)
+  }
+}
@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    dx = {
+      source  = "registry.terraform.io/get-dx/dx"
+      version = "~> 0.11.0"
+    }
+  }
+}
+
+provider "dx" {}
+
+# Example 1: List every scorecard
+data "dx_scorecards" "all" {}
+
+output "scorecard_names" {
+  value = [for scorecard in data.dx_scorecards.all.scorecards : scorecard.name]
+}
+
+# Example 2: List the published level based scorecards with a given tag
+data "dx_scorecards" "platform" {
+  type      = "LEVEL"
+  tag       = "platform"
+  published = true
+}
+
+output "platform_scorecard_ids" {
+  value = { for scorecard in data.dx_scorecards.platform.scorecards : scorecard.name => scorecard.id }
+}
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    dx = {
+      source  = "registry.terraform.io/get-dx/dx"
+      version = "~> 0.11.0"
+    }
+  }
+}
+
+provider "dx" {}
+
+# Look up a team by name, and use its ID as an entity owner
+data "dx_team" "payments" {
+  name = "Payments"
+}
+
+resource "dx_entity" "checkout_service" {
+  identifier     = "checkout-service"
+  type           = "service"
+  owner_team_ids = [data.dx_team.payments.id]
+}
+
+# Teams can also be looked up by ID
+data "dx_team" "payments_parent" {
+  id = data.dx_team.payments.parent_id
+}
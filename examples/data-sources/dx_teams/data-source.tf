@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    dx = {
+      source  = "registry.terraform.io/get-dx/dx"
+      version = "~> 0.11.0"
+    }
+  }
+}
+
+provider "dx" {}
+
+# List the teams at the top of the team hierarchy
+data "dx_teams" "top_level" {
+  top_level = true
+}
+
+# List the direct children of a team
+data "dx_teams" "engineering" {
+  parent_id = one([for team in data.dx_teams.top_level.teams : team.id if team.name == "Engineering"])
+}
+
+output "engineering_team_ids_by_name" {
+  value = { for team in data.dx_teams.engineering.teams : team.name => team.id }
+}
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    dx = {
+      source  = "registry.terraform.io/get-dx/dx"
+      version = "~> 0.11.0"
+    }
+  }
+}
+
+provider "dx" {}
+
+# Look up a user by email, and use their ID as an entity owner
+data "dx_user" "jane" {
+  email = "jane.doe@example.com"
+}
+
+resource "dx_entity" "checkout_service" {
+  identifier     = "checkout-service"
+  type           = "service"
+  owner_user_ids = [data.dx_user.jane.id]
+}
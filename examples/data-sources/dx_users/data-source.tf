@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    dx = {
+      source  = "registry.terraform.io/get-dx/dx"
+      version = "~> 0.11.0"
+    }
+  }
+}
+
+provider "dx" {}
+
+# Map every user's email to their DX ID
+data "dx_users" "all" {}
+
+output "user_ids_by_email" {
+  value = { for user in data.dx_users.all.users : lower(user.email) => user.id }
+}
@@ -0,0 +1,18 @@
+provider "dx" {
+  alias    = "staging"
+  base_url = "https://dx.staging.example.com/api"
+
+  request_timeout = "2m"
+  ca_cert_pem     = file("${path.module}/staging-ca.pem")
+
+  custom_headers = {
+    "X-Environment" = "staging"
+  }
+}
+
+resource "dx_entity_type" "staging_repository" {
+  provider = dx.staging
+
+  identifier = "repository"
+  name       = "Repository"
+}
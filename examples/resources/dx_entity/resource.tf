@@ -43,6 +43,11 @@ resource "dx_entity" "payment_service" {
   }
 }
 
+# Link to the repository behind the service, as resolved by DX
+output "payment_service_repo_url" {
+  value = dx_entity.payment_service.alias_details["github_repo"][0].url
+}
+
 # Example 2: Create an API entity with minimal configuration
 resource "dx_entity" "user_api" {
   identifier     = "user-api"
@@ -69,3 +74,19 @@ resource "dx_entity" "user_api" {
   }
 }
 
+# Example 3: Set properties through the typed property maps, so each value keeps its type
+resource "dx_entity" "checkout_service" {
+  identifier = "checkout-service"
+  type       = "service"
+  name       = "Checkout Service"
+
+  # Owners can be set by team name and user email instead of DX IDs
+  owner_team_names  = ["Payments"]
+  owner_user_emails = ["jane.doe@example.com"]
+
+  text_properties    = { service_tier = "Tier-1" }
+  number_properties  = { replicas = 3 }
+  boolean_properties = { pci_scope = true }
+  list_properties    = { language = ["Go"] }
+  date_properties    = { launched_on = "2024-01-15" }
+}
@@ -0,0 +1,2 @@
+# Entity relationships are imported by <relation_identifier>:<source_entity_identifier>:<target_entity_identifier>
+terraform import dx_entity_relationship.checkout_depends_on_payments service-depends-on-service:checkout:payments
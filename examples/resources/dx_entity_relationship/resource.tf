@@ -0,0 +1,14 @@
+resource "dx_catalog_relation" "service_depends_on_service" {
+  identifier                    = "service-depends-on-service"
+  type                          = "depends on"
+  cardinality                   = "many_to_many"
+  source_entity_type_identifier = "service"
+  target_entity_type_identifier = "service"
+}
+
+# Record that the checkout service depends on the payments service
+resource "dx_entity_relationship" "checkout_depends_on_payments" {
+  relation_identifier      = dx_catalog_relation.service_depends_on_service.identifier
+  source_entity_identifier = "checkout"
+  target_entity_identifier = "payments"
+}
@@ -0,0 +1,2 @@
+# Scorecard checks are imported by <scorecard_id>:<check_id>
+terraform import dx_scorecard_check.payments_runbook 3f9a1c2e:7b2d4e6f
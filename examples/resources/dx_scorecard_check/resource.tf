@@ -0,0 +1,61 @@
+terraform {
+  required_providers {
+    dx = {
+      source  = "registry.terraform.io/get-dx/dx"
+      version = "~> 0.11.0"
+    }
+  }
+}
+
+provider "dx" {}
+
+# The platform team owns the scorecard and its levels. Checks contributed by other teams are left
+# alone because of ignore_external_checks.
+resource "dx_scorecard" "production_readiness" {
+  name                           = "Production Readiness"
+  type                           = "LEVEL"
+  entity_filter_type             = "entity_types"
+  entity_filter_type_identifiers = ["service"]
+  evaluation_frequency_hours     = 24
+  empty_level_label              = "Not ready"
+  empty_level_color              = "#cccccc"
+  ignore_external_checks         = true
+
+  levels = {
+    bronze = {
+      name  = "Bronze"
+      color = "#FB923C"
+      rank  = 1
+    },
+    silver = {
+      name  = "Silver"
+      color = "#9CA3AF"
+      rank  = 2
+    },
+  }
+
+  checks = {
+    has_owner = {
+      name                = "Has an owner"
+      scorecard_level_key = "bronze"
+      ordering            = 0
+      sql                 = "select 'PASS' as status"
+      output_enabled      = false
+      published           = true
+    }
+  }
+}
+
+# A service team contributes its own check, e.g. from another configuration
+resource "dx_scorecard_check" "payments_runbook" {
+  scorecard_id        = dx_scorecard.production_readiness.id
+  scorecard_level_key = "silver"
+
+  name           = "Has a payments runbook"
+  description    = "Payments services link their on-call runbook."
+  ordering       = 0
+  sql            = "select 'PASS' as status"
+  output_enabled = false
+  external_url   = "https://example.com/runbooks"
+  published      = true
+}
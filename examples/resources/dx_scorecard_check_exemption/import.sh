@@ -0,0 +1,2 @@
+# Scorecard check exemptions are imported by their ID
+terraform import dx_scorecard_check_exemption.legacy_billing_runbook 5c8e2a1d
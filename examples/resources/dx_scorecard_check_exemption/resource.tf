@@ -0,0 +1,56 @@
+terraform {
+  required_providers {
+    dx = {
+      source  = "registry.terraform.io/get-dx/dx"
+      version = "~> 0.11.0"
+    }
+  }
+}
+
+provider "dx" {}
+
+resource "dx_scorecard" "production_readiness" {
+  name                           = "Production Readiness"
+  type                           = "LEVEL"
+  entity_filter_type             = "entity_types"
+  entity_filter_type_identifiers = ["service"]
+  evaluation_frequency_hours     = 4
+  empty_level_label              = "Not Ready"
+  empty_level_color              = "#cccccc"
+
+  levels = {
+    bronze = {
+      name  = "Bronze"
+      color = "#FB923C"
+      rank  = 1
+    }
+  }
+
+  checks = {
+    has_runbook = {
+      name                = "Has a runbook"
+      scorecard_level_key = "bronze"
+      ordering            = 0
+      sql                 = <<-EOT
+        select case
+            when count(*) > 0 then 'PASS'
+            else 'FAIL'
+          end as status
+        from dx_catalog_entity_links
+        where entity_identifier = $entity_identifier
+          and type = 'runbook'
+      EOT
+      output_enabled      = false
+      published           = true
+    }
+  }
+}
+
+# The legacy billing service is being retired and will not get a runbook
+resource "dx_scorecard_check_exemption" "legacy_billing_runbook" {
+  scorecard_id      = dx_scorecard.production_readiness.id
+  check_id          = dx_scorecard.production_readiness.checks["has_runbook"].id
+  entity_identifier = "legacy-billing"
+  reason            = "Retired in Q3, see the billing migration plan."
+  expires_at        = "2027-10-01T00:00:00Z"
+}
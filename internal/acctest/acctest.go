@@ -1,13 +1,20 @@
 package acctest
 
 import (
+	"context"
+	"fmt"
 	"math/rand"
+	"net/http/httptest"
 	"os"
+	"terraform-provider-dx/dx/dxapi"
+	"terraform-provider-dx/internal/dxfake"
 	"terraform-provider-dx/internal/provider"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 var (
@@ -20,12 +27,61 @@ var (
 	}
 )
 
+// TestAccPreCheck prepares the DX API an acceptance test runs against. When DX_WEB_API_TOKEN is
+// set the test uses the real API, otherwise it gets its own in-memory fake from dxfake, and
+// DX_WEB_API_FAKE is set.
 func TestAccPreCheck(t *testing.T) {
-	if v := os.Getenv("DX_WEB_API_TOKEN"); v == "" {
-		t.Fatal("DX_WEB_API_TOKEN must be set for acceptance tests")
+	if v := os.Getenv("DX_WEB_API_TOKEN"); v != "" {
+		return
+	}
+
+	server := httptest.NewServer(dxfake.New())
+	t.Cleanup(server.Close)
+	t.Setenv("DX_WEB_API_URL", server.URL)
+	t.Setenv("DX_WEB_API_TOKEN", dxfake.Token)
+	t.Setenv("DX_WEB_API_FAKE", "1")
+}
+
+// SkipWithRealAPI skips a test that relies on the teams, users or other data dxfake is seeded
+// with when DX_WEB_API_TOKEN points the acceptance tests at a real DX account.
+func SkipWithRealAPI(t *testing.T) {
+	if os.Getenv("DX_WEB_API_TOKEN") != "" {
+		t.Skip("Relies on data seeded in the fake DX API, skipping against a real DX account")
 	}
 }
 
 func RandInt() int {
 	return rand.Intn(1000000000)
 }
+
+// Client returns a DX API client configured from the same environment variables as the provider,
+// for tests that need to change objects behind Terraform's back.
+func Client() *dxapi.Client {
+	baseURL := os.Getenv("DX_WEB_API_URL")
+	if baseURL == "" {
+		baseURL = "https://api.getdx.com"
+	}
+	return dxapi.NewClient(baseURL, os.Getenv("DX_WEB_API_TOKEN"), "acceptance-tests")
+}
+
+// CheckDeletedOutOfBand deletes the object behind resourceName directly through the DX API,
+// simulating someone removing it in the DX UI. attribute names the state attribute holding the
+// identifier that deleteFn expects, e.g. `(*dxapi.Client).DeleteEntity`.
+func CheckDeletedOutOfBand(resourceName, attribute string, deleteFn func(client *dxapi.Client, ctx context.Context, id string) (bool, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		id := rs.Primary.Attributes[attribute]
+		if id == "" {
+			return fmt.Errorf("resource %s has no %s in state", resourceName, attribute)
+		}
+
+		if _, err := deleteFn(Client(), context.Background(), id); err != nil {
+			return fmt.Errorf("deleting %s out of band: %w", resourceName, err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,402 @@
+package dxfake
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type entity struct {
+	Id          string                 `json:"id"`
+	Identifier  string                 `json:"identifier"`
+	Name        *string                `json:"name"`
+	Type        string                 `json:"type"`
+	Description *string                `json:"description"`
+	CreatedAt   string                 `json:"created_at"`
+	UpdatedAt   string                 `json:"updated_at"`
+	OwnerTeams  []Team                 `json:"owner_teams"`
+	OwnerUsers  []User                 `json:"owner_users"`
+	Domain      *domain                `json:"domain"`
+	Properties  map[string]interface{} `json:"properties"`
+	Aliases     map[string][]alias     `json:"aliases"`
+}
+
+type domain struct {
+	Identifier string `json:"identifier"`
+}
+
+type alias struct {
+	Identifier         string  `json:"identifier"`
+	InstanceIdentifier *string `json:"instance_identifier"`
+	// Name and URL are resolved by DX from the integration the alias points into. Clients cannot
+	// set them.
+	Name *string `json:"name"`
+	Url  *string `json:"url"`
+}
+
+// entityRequest is the body of entities.create and entities.update.
+type entityRequest struct {
+	Identifier   string                 `json:"identifier"`
+	Type         string                 `json:"type"`
+	Name         *string                `json:"name"`
+	Description  *string                `json:"description"`
+	OwnerTeamIds []string               `json:"owner_team_ids"`
+	OwnerUserIds []string               `json:"owner_user_ids"`
+	Domain       *string                `json:"domain"`
+	Properties   map[string]interface{} `json:"properties"`
+	Aliases      map[string][]alias     `json:"aliases"`
+}
+
+func (s *Server) createEntity(req *request) (interface{}, *apiError) {
+	var body entityRequest
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	errs := fieldErrors{}
+	if body.Identifier == "" {
+		errs["identifier"] = "is required"
+	}
+	et, ok := s.entityTypes[body.Type]
+	if !ok {
+		errs["type"] = fmt.Sprintf("entity type %q does not exist", body.Type)
+	}
+	if err := errs.check(); err != nil {
+		return nil, err
+	}
+	if _, ok := s.entities[body.Identifier]; ok {
+		return nil, alreadyExists("entity", body.Identifier)
+	}
+
+	e := &entity{
+		Id:          s.newID(),
+		Identifier:  body.Identifier,
+		Type:        body.Type,
+		Name:        body.Name,
+		Description: body.Description,
+		Properties:  map[string]interface{}{},
+		Aliases:     map[string][]alias{},
+	}
+	s.applyEntityOwners(e, body, errs)
+	s.applyEntityDomain(e, body.Domain, errs)
+	applyEntityProperties(e, et, body.Properties, errs)
+	applyEntityAliases(e, et, body.Aliases, errs)
+	if err := errs.check(); err != nil {
+		return nil, err
+	}
+
+	e.CreatedAt = s.timestamp()
+	e.UpdatedAt = e.CreatedAt
+	s.entities[e.Identifier] = e
+
+	return entityResponse(e), nil
+}
+
+func (s *Server) getEntity(req *request) (interface{}, *apiError) {
+	e, ok := s.entities[req.param("identifier")]
+	if !ok {
+		return nil, notFound("entity")
+	}
+	return entityResponse(e), nil
+}
+
+// updateEntity changes the fields present in the request. Properties and aliases are merged into
+// the existing ones: a null property value deletes the property and an empty alias list removes
+// the alias type.
+func (s *Server) updateEntity(req *request) (interface{}, *apiError) {
+	existing, ok := s.entities[req.param("identifier")]
+	if !ok {
+		return nil, notFound("entity")
+	}
+
+	var body entityRequest
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Type != "" && body.Type != existing.Type {
+		return nil, validationError(fieldErrors{"type": "cannot be changed"})
+	}
+	et := s.entityTypes[existing.Type]
+
+	updated := *existing
+	updated.Properties = copyMap(existing.Properties)
+	updated.Aliases = copyMap(existing.Aliases)
+
+	errs := fieldErrors{}
+	if req.has("name") {
+		updated.Name = body.Name
+	}
+	if req.has("description") {
+		updated.Description = body.Description
+	}
+	if req.has("owner_team_ids") || req.has("owner_user_ids") {
+		if !req.has("owner_team_ids") {
+			body.OwnerTeamIds = teamIDs(existing.OwnerTeams)
+		}
+		if !req.has("owner_user_ids") {
+			body.OwnerUserIds = userIDs(existing.OwnerUsers)
+		}
+		s.applyEntityOwners(&updated, body, errs)
+	}
+	if req.has("domain") {
+		s.applyEntityDomain(&updated, body.Domain, errs)
+	}
+	applyEntityProperties(&updated, et, body.Properties, errs)
+	applyEntityAliases(&updated, et, body.Aliases, errs)
+	if err := errs.check(); err != nil {
+		return nil, err
+	}
+
+	updated.UpdatedAt = s.timestamp()
+	s.entities[updated.Identifier] = &updated
+
+	return entityResponse(&updated), nil
+}
+
+func (s *Server) deleteEntity(req *request) (interface{}, *apiError) {
+	identifier := req.param("identifier")
+	if _, ok := s.entities[identifier]; !ok {
+		return nil, notFound("entity")
+	}
+	delete(s.entities, identifier)
+	s.removeEntityRelationships(func(edge *entityRelationship) bool {
+		return edge.SourceEntityIdentifier == identifier || edge.TargetEntityIdentifier == identifier
+	})
+	s.removeExemptions(func(ex *exemption) bool {
+		return ex.EntityIdentifier == identifier
+	})
+	return okResponse(), nil
+}
+
+// listEntities returns one page of the entities of a type, ordered by identifier. The cursor is
+// the offset of the next page.
+func (s *Server) listEntities(req *request) (interface{}, *apiError) {
+	entityType := req.query.Get("type")
+	if entityType == "" {
+		return nil, validationError(fieldErrors{"type": "is required"})
+	}
+	if _, ok := s.entityTypes[entityType]; !ok {
+		return nil, notFound("entity_type")
+	}
+
+	limit, offset, err := pageParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	searchTerm := strings.ToLower(req.query.Get("search_term"))
+	matches := []*entity{}
+	for _, e := range s.entities {
+		if e.Type != entityType {
+			continue
+		}
+		if searchTerm != "" && !strings.Contains(strings.ToLower(e.Identifier), searchTerm) &&
+			(e.Name == nil || !strings.Contains(strings.ToLower(*e.Name), searchTerm)) {
+			continue
+		}
+		matches = append(matches, e)
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Identifier < matches[j].Identifier })
+
+	page, nextCursor := paginate(matches, limit, offset)
+	return map[string]interface{}{
+		"ok":                true,
+		"entities":          page,
+		"response_metadata": map[string]string{"next_cursor": nextCursor},
+	}, nil
+}
+
+func (s *Server) applyEntityOwners(e *entity, body entityRequest, errs fieldErrors) {
+	e.OwnerTeams = []Team{}
+	for i, id := range body.OwnerTeamIds {
+		team, ok := s.teams[id]
+		if !ok {
+			errs[fmt.Sprintf("owner_team_ids.%d", i)] = fmt.Sprintf("team %q does not exist", id)
+			continue
+		}
+		e.OwnerTeams = append(e.OwnerTeams, team)
+	}
+
+	e.OwnerUsers = []User{}
+	for i, id := range body.OwnerUserIds {
+		user, ok := s.users[id]
+		if !ok {
+			errs[fmt.Sprintf("owner_user_ids.%d", i)] = fmt.Sprintf("user %q does not exist", id)
+			continue
+		}
+		e.OwnerUsers = append(e.OwnerUsers, user)
+	}
+}
+
+func (s *Server) applyEntityDomain(e *entity, identifier *string, errs fieldErrors) {
+	if identifier == nil || *identifier == "" {
+		e.Domain = nil
+		return
+	}
+	if _, ok := s.entities[*identifier]; !ok {
+		errs["domain"] = fmt.Sprintf("entity %q does not exist", *identifier)
+		return
+	}
+	e.Domain = &domain{Identifier: *identifier}
+}
+
+// applyEntityProperties merges properties into the entity, validating each value against the
+// property definition of the entity type.
+func applyEntityProperties(e *entity, et *entityType, properties map[string]interface{}, errs fieldErrors) {
+	definitions := map[string]property{}
+	for _, prop := range et.Properties {
+		definitions[prop.Identifier] = prop
+	}
+
+	for _, key := range sortedKeys(properties) {
+		value := properties[key]
+		field := "properties." + key
+		if value == nil {
+			delete(e.Properties, key)
+			continue
+		}
+
+		prop, ok := definitions[key]
+		if !ok {
+			errs[field] = fmt.Sprintf("entity type %q has no property %q", et.Identifier, key)
+			continue
+		}
+		if msg := validatePropertyValue(prop, value); msg != "" {
+			errs[field] = msg
+			continue
+		}
+		e.Properties[key] = value
+	}
+}
+
+func validatePropertyValue(prop property, value interface{}) string {
+	switch prop.Type {
+	case "multi_select", "list":
+		values, ok := value.([]interface{})
+		if !ok {
+			return "must be a list"
+		}
+		if prop.Type == "list" {
+			return ""
+		}
+		options := propertyOptions(prop)
+		for _, v := range values {
+			if s, ok := v.(string); !ok || !options[s] {
+				return fmt.Sprintf("%v is not an option of %s", v, prop.Identifier)
+			}
+		}
+	case "select":
+		if s, ok := value.(string); !ok || !propertyOptions(prop)[s] {
+			return fmt.Sprintf("%v is not an option of %s", value, prop.Identifier)
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return "must be a number"
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return "must be a boolean"
+		}
+	case "computed":
+		return "computed properties cannot be set"
+	default:
+		if _, ok := value.(string); !ok {
+			return "must be a string"
+		}
+	}
+	return ""
+}
+
+// applyEntityAliases merges aliases into the entity. An empty list removes the alias type.
+func applyEntityAliases(e *entity, et *entityType, aliases map[string][]alias, errs fieldErrors) {
+	for _, aliasType := range sortedKeys(aliases) {
+		if len(aliases[aliasType]) == 0 {
+			delete(e.Aliases, aliasType)
+			continue
+		}
+		if !et.Aliases[aliasType] {
+			errs["aliases."+aliasType] = fmt.Sprintf("alias type %q is not enabled for entity type %q", aliasType, et.Identifier)
+			continue
+		}
+		resolved := make([]alias, 0, len(aliases[aliasType]))
+		for _, a := range aliases[aliasType] {
+			resolved = append(resolved, resolveAlias(aliasType, a))
+		}
+		e.Aliases[aliasType] = resolved
+	}
+}
+
+// resolveAlias fills in the name and URL DX would find for an alias in Data Cloud. The fake only
+// knows GitHub repositories; other aliases stay unresolved.
+func resolveAlias(aliasType string, a alias) alias {
+	a.Name, a.Url = nil, nil
+	if aliasType == "github_repo" {
+		name := "repository-" + a.Identifier
+		url := "https://github.com/example/" + name
+		a.Name, a.Url = &name, &url
+	}
+	return a
+}
+
+// pageParams reads the limit and cursor of a list request.
+func pageParams(req *request) (limit, offset int, err *apiError) {
+	limit = 50
+	if raw := req.query.Get("limit"); raw != "" {
+		n, convErr := strconv.Atoi(raw)
+		if convErr != nil || n < 1 || n > 100 {
+			return 0, 0, validationError(fieldErrors{"limit": "must be between 1 and 100"})
+		}
+		limit = n
+	}
+	if raw := req.query.Get("cursor"); raw != "" {
+		n, convErr := strconv.Atoi(raw)
+		if convErr != nil || n < 0 {
+			return 0, 0, &apiError{status: http.StatusBadRequest, code: "invalid_cursor"}
+		}
+		offset = n
+	}
+	return limit, offset, nil
+}
+
+// paginate returns the page of items starting at offset and the cursor of the following page,
+// which is empty on the last page.
+func paginate[T any](items []T, limit, offset int) ([]T, string) {
+	if offset >= len(items) {
+		return []T{}, ""
+	}
+	end := min(offset+limit, len(items))
+	if end == len(items) {
+		return items[offset:end], ""
+	}
+	return items[offset:end], strconv.Itoa(end)
+}
+
+func teamIDs(teams []Team) []string {
+	ids := make([]string, 0, len(teams))
+	for _, team := range teams {
+		ids = append(ids, team.Id)
+	}
+	return ids
+}
+
+func userIDs(users []User) []string {
+	ids := make([]string, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.Id)
+	}
+	return ids
+}
+
+func copyMap[V any](m map[string]V) map[string]V {
+	copied := make(map[string]V, len(m))
+	for key, value := range m {
+		copied[key] = value
+	}
+	return copied
+}
+
+func entityResponse(e *entity) map[string]interface{} {
+	return map[string]interface{}{"ok": true, "entity": e}
+}
@@ -0,0 +1,145 @@
+package dxfake
+
+import "fmt"
+
+type entityRelationship struct {
+	RelationIdentifier     string `json:"relation_identifier"`
+	SourceEntityIdentifier string `json:"source_entity_identifier"`
+	TargetEntityIdentifier string `json:"target_entity_identifier"`
+	CreatedAt              string `json:"created_at"`
+}
+
+// createEntityRelationship connects two entities. Like the API, it checks that the entities have
+// the entity types of the relation definition and that the edge respects its cardinality.
+func (s *Server) createEntityRelationship(req *request) (interface{}, *apiError) {
+	var body entityRelationship
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	errs := fieldErrors{}
+	rel, ok := s.relations[body.RelationIdentifier]
+	if !ok {
+		errs["relation_identifier"] = fmt.Sprintf("relation %q does not exist", body.RelationIdentifier)
+	}
+	source, ok := s.entities[body.SourceEntityIdentifier]
+	if !ok {
+		errs["source_entity_identifier"] = fmt.Sprintf("entity %q does not exist", body.SourceEntityIdentifier)
+	}
+	target, ok := s.entities[body.TargetEntityIdentifier]
+	if !ok {
+		errs["target_entity_identifier"] = fmt.Sprintf("entity %q does not exist", body.TargetEntityIdentifier)
+	}
+	if err := errs.check(); err != nil {
+		return nil, err
+	}
+
+	if source.Type != rel.SourceEntityTypeIdentifier {
+		errs["source_entity_identifier"] = fmt.Sprintf("must be a %q entity, got a %q entity", rel.SourceEntityTypeIdentifier, source.Type)
+	}
+	if target.Type != rel.TargetEntityTypeIdentifier {
+		errs["target_entity_identifier"] = fmt.Sprintf("must be a %q entity, got a %q entity", rel.TargetEntityTypeIdentifier, target.Type)
+	}
+	if err := errs.check(); err != nil {
+		return nil, err
+	}
+
+	if s.findEntityRelationship(body.RelationIdentifier, body.SourceEntityIdentifier, body.TargetEntityIdentifier) >= 0 {
+		return nil, alreadyExists("entity_relationship", fmt.Sprintf("%s:%s:%s", body.RelationIdentifier, body.SourceEntityIdentifier, body.TargetEntityIdentifier))
+	}
+	for _, existing := range s.entityRelationships {
+		if existing.RelationIdentifier != rel.Identifier {
+			continue
+		}
+		// The "one" side of a cardinality may be connected to at most one entity
+		if (rel.Cardinality == "one_to_one" || rel.Cardinality == "many_to_one") && existing.SourceEntityIdentifier == body.SourceEntityIdentifier {
+			errs["source_entity_identifier"] = fmt.Sprintf("already has a %q relationship to %q", rel.Identifier, existing.TargetEntityIdentifier)
+		}
+		if (rel.Cardinality == "one_to_one" || rel.Cardinality == "one_to_many") && existing.TargetEntityIdentifier == body.TargetEntityIdentifier {
+			errs["target_entity_identifier"] = fmt.Sprintf("already has a %q relationship from %q", rel.Identifier, existing.SourceEntityIdentifier)
+		}
+	}
+	if err := errs.check(); err != nil {
+		return nil, err
+	}
+
+	body.CreatedAt = s.timestamp()
+	s.entityRelationships = append(s.entityRelationships, &body)
+
+	return entityRelationshipResponse(&body), nil
+}
+
+func (s *Server) getEntityRelationship(req *request) (interface{}, *apiError) {
+	i := s.findEntityRelationship(req.param("relation_identifier"), req.param("source_entity_identifier"), req.param("target_entity_identifier"))
+	if i < 0 {
+		return nil, notFound("entity_relationship")
+	}
+	return entityRelationshipResponse(s.entityRelationships[i]), nil
+}
+
+func (s *Server) deleteEntityRelationship(req *request) (interface{}, *apiError) {
+	i := s.findEntityRelationship(req.param("relation_identifier"), req.param("source_entity_identifier"), req.param("target_entity_identifier"))
+	if i < 0 {
+		return nil, notFound("entity_relationship")
+	}
+	s.entityRelationships = append(s.entityRelationships[:i], s.entityRelationships[i+1:]...)
+	return okResponse(), nil
+}
+
+// listEntityRelationships returns one page of the entity relationships matching every given
+// filter, in creation order.
+func (s *Server) listEntityRelationships(req *request) (interface{}, *apiError) {
+	limit, offset, err := pageParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	relationIdentifier := req.query.Get("relation_identifier")
+	sourceEntityIdentifier := req.query.Get("source_entity_identifier")
+	targetEntityIdentifier := req.query.Get("target_entity_identifier")
+
+	matches := []*entityRelationship{}
+	for _, edge := range s.entityRelationships {
+		if (relationIdentifier != "" && edge.RelationIdentifier != relationIdentifier) ||
+			(sourceEntityIdentifier != "" && edge.SourceEntityIdentifier != sourceEntityIdentifier) ||
+			(targetEntityIdentifier != "" && edge.TargetEntityIdentifier != targetEntityIdentifier) {
+			continue
+		}
+		matches = append(matches, edge)
+	}
+
+	page, nextCursor := paginate(matches, limit, offset)
+	return map[string]interface{}{
+		"ok":                   true,
+		"entity_relationships": page,
+		"response_metadata":    map[string]string{"next_cursor": nextCursor},
+	}, nil
+}
+
+// findEntityRelationship returns the index of an entity relationship, or -1 if there is none.
+func (s *Server) findEntityRelationship(relationIdentifier, sourceEntityIdentifier, targetEntityIdentifier string) int {
+	for i, edge := range s.entityRelationships {
+		if edge.RelationIdentifier == relationIdentifier &&
+			edge.SourceEntityIdentifier == sourceEntityIdentifier &&
+			edge.TargetEntityIdentifier == targetEntityIdentifier {
+			return i
+		}
+	}
+	return -1
+}
+
+// removeEntityRelationships drops the entity relationships for which drop returns true. It keeps
+// edges consistent when the entities or relation definitions they reference are deleted.
+func (s *Server) removeEntityRelationships(drop func(edge *entityRelationship) bool) {
+	kept := s.entityRelationships[:0]
+	for _, edge := range s.entityRelationships {
+		if !drop(edge) {
+			kept = append(kept, edge)
+		}
+	}
+	s.entityRelationships = kept
+}
+
+func entityRelationshipResponse(edge *entityRelationship) map[string]interface{} {
+	return map[string]interface{}{"ok": true, "entity_relationship": edge}
+}
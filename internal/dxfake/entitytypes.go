@@ -0,0 +1,218 @@
+package dxfake
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+)
+
+type entityType struct {
+	Identifier  string          `json:"identifier"`
+	Name        string          `json:"name"`
+	Description *string         `json:"description"`
+	CreatedAt   string          `json:"created_at"`
+	UpdatedAt   string          `json:"updated_at"`
+	Ordering    int64           `json:"ordering"`
+	Properties  []property      `json:"properties"`
+	Aliases     map[string]bool `json:"aliases"`
+}
+
+type property struct {
+	Identifier  string                 `json:"identifier"`
+	Name        string                 `json:"name"`
+	Type        string                 `json:"type"`
+	Description *string                `json:"description"`
+	Visibility  *string                `json:"visibility"`
+	Ordering    *int64                 `json:"ordering"`
+	Definition  map[string]interface{} `json:"definition"`
+}
+
+// propertyTypes are the property types an entity type can define.
+var propertyTypes = map[string]bool{
+	"boolean":      true,
+	"computed":     true,
+	"date":         true,
+	"json":         true,
+	"list":         true,
+	"multi_select": true,
+	"number":       true,
+	"openapi":      true,
+	"select":       true,
+	"text":         true,
+	"url":          true,
+	"user":         true,
+}
+
+var identifierPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+func (s *Server) createEntityType(req *request) (interface{}, *apiError) {
+	var et entityType
+	if err := req.decode(&et); err != nil {
+		return nil, err
+	}
+
+	errs := fieldErrors{}
+	if !identifierPattern.MatchString(et.Identifier) {
+		errs["identifier"] = "must be lowercase letters, digits, dashes and underscores"
+	}
+	validateEntityType(&et, errs)
+	if err := errs.check(); err != nil {
+		return nil, err
+	}
+	if _, ok := s.entityTypes[et.Identifier]; ok {
+		return nil, alreadyExists("entity_type", et.Identifier)
+	}
+
+	et.Ordering = int64(len(s.entityTypes))
+	et.CreatedAt = s.timestamp()
+	et.UpdatedAt = et.CreatedAt
+	if et.Properties == nil {
+		et.Properties = []property{}
+	}
+	if et.Aliases == nil {
+		et.Aliases = map[string]bool{}
+	}
+	s.entityTypes[et.Identifier] = &et
+
+	return entityTypeResponse(&et), nil
+}
+
+func (s *Server) getEntityType(req *request) (interface{}, *apiError) {
+	et, ok := s.entityTypes[req.param("identifier")]
+	if !ok {
+		return nil, notFound("entity_type")
+	}
+	return entityTypeResponse(et), nil
+}
+
+// updateEntityType replaces the fields present in the request. Properties are replaced as a
+// whole, so properties missing from the request are deleted.
+func (s *Server) updateEntityType(req *request) (interface{}, *apiError) {
+	existing, ok := s.entityTypes[req.param("identifier")]
+	if !ok {
+		return nil, notFound("entity_type")
+	}
+
+	updated := *existing
+	if req.has("aliases") {
+		updated.Aliases = nil
+	}
+	for field, target := range map[string]interface{}{
+		"name":        &updated.Name,
+		"description": &updated.Description,
+		"properties":  &updated.Properties,
+		"aliases":     &updated.Aliases,
+	} {
+		if err := req.decodeField(field, target); err != nil {
+			return nil, err
+		}
+	}
+
+	errs := fieldErrors{}
+	validateEntityType(&updated, errs)
+	if err := errs.check(); err != nil {
+		return nil, err
+	}
+
+	if updated.Properties == nil {
+		updated.Properties = []property{}
+	}
+	updated.UpdatedAt = s.timestamp()
+	s.entityTypes[updated.Identifier] = &updated
+
+	return entityTypeResponse(&updated), nil
+}
+
+func (s *Server) deleteEntityType(req *request) (interface{}, *apiError) {
+	identifier := req.param("identifier")
+	if _, ok := s.entityTypes[identifier]; !ok {
+		return nil, notFound("entity_type")
+	}
+	for _, e := range s.entities {
+		if e.Type == identifier {
+			return nil, &apiError{
+				status:  http.StatusConflict,
+				code:    "entity_type_in_use",
+				message: fmt.Sprintf("Entity type %q still has entities", identifier),
+			}
+		}
+	}
+
+	delete(s.entityTypes, identifier)
+	return okResponse(), nil
+}
+
+// listEntityTypes returns one page of every entity type, ordered by identifier.
+func (s *Server) listEntityTypes(req *request) (interface{}, *apiError) {
+	limit, offset, err := pageParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	all := []*entityType{}
+	for _, identifier := range sortedKeys(s.entityTypes) {
+		all = append(all, s.entityTypes[identifier])
+	}
+
+	page, nextCursor := paginate(all, limit, offset)
+	return map[string]interface{}{
+		"ok":                true,
+		"entity_types":      page,
+		"response_metadata": map[string]string{"next_cursor": nextCursor},
+	}, nil
+}
+
+func validateEntityType(et *entityType, errs fieldErrors) {
+	if et.Name == "" {
+		errs["name"] = "is required"
+	}
+
+	seen := map[string]bool{}
+	for i, prop := range et.Properties {
+		field := fmt.Sprintf("properties.%d", i)
+		switch {
+		case !identifierPattern.MatchString(prop.Identifier):
+			errs[field+".identifier"] = "must be lowercase letters, digits, dashes and underscores"
+		case seen[prop.Identifier]:
+			errs[field+".identifier"] = fmt.Sprintf("duplicate property %q", prop.Identifier)
+		}
+		seen[prop.Identifier] = true
+
+		if prop.Name == "" {
+			errs[field+".name"] = "is required"
+		}
+		if !propertyTypes[prop.Type] {
+			errs[field+".type"] = fmt.Sprintf("unknown property type %q", prop.Type)
+		}
+		if prop.Visibility != nil && *prop.Visibility != "visible" && *prop.Visibility != "hidden" {
+			errs[field+".visibility"] = "must be visible or hidden"
+		}
+		if prop.Type == "computed" {
+			if sql, _ := prop.Definition["sql"].(string); sql == "" {
+				errs[field+".definition.sql"] = "is required for computed properties"
+			}
+		}
+	}
+}
+
+// propertyOptions returns the allowed values of a select or multi_select property.
+func propertyOptions(prop property) map[string]bool {
+	values := map[string]bool{}
+	options, _ := prop.Definition["options"].([]interface{})
+	for _, option := range options {
+		if option, ok := option.(map[string]interface{}); ok {
+			if value, ok := option["value"].(string); ok {
+				values[value] = true
+			}
+		}
+	}
+	return values
+}
+
+func entityTypeResponse(et *entityType) map[string]interface{} {
+	return map[string]interface{}{"ok": true, "entity_type": et}
+}
+
+func okResponse() map[string]interface{} {
+	return map[string]interface{}{"ok": true}
+}
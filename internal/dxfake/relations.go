@@ -0,0 +1,157 @@
+package dxfake
+
+import "fmt"
+
+type relation struct {
+	Identifier                 string  `json:"identifier"`
+	Type                       string  `json:"type"`
+	InverseType                string  `json:"inverse_type"`
+	Cardinality                string  `json:"cardinality"`
+	Description                *string `json:"description"`
+	SourceEntityTypeIdentifier string  `json:"source_entity_type_identifier"`
+	TargetEntityTypeIdentifier string  `json:"target_entity_type_identifier"`
+	CreatedAt                  string  `json:"created_at"`
+	UpdatedAt                  string  `json:"updated_at"`
+}
+
+// inverseRelationTypes maps each relation type to the type seen from the target entity.
+var inverseRelationTypes = map[string]string{
+	"consumes":   "consumed by",
+	"depends on": "dependency of",
+	"parent of":  "child of",
+	"part of":    "has part",
+	"provides":   "provided by",
+	"manages":    "managed by",
+}
+
+var cardinalities = map[string]bool{
+	"one_to_one":   true,
+	"one_to_many":  true,
+	"many_to_one":  true,
+	"many_to_many": true,
+}
+
+func (s *Server) createRelation(req *request) (interface{}, *apiError) {
+	var rel relation
+	if err := req.decode(&rel); err != nil {
+		return nil, err
+	}
+
+	errs := fieldErrors{}
+	if !identifierPattern.MatchString(rel.Identifier) {
+		errs["identifier"] = "must be lowercase letters, digits, dashes and underscores"
+	}
+	validateRelationType(rel.Type, errs)
+	if !cardinalities[rel.Cardinality] {
+		errs["cardinality"] = fmt.Sprintf("unknown cardinality %q", rel.Cardinality)
+	}
+	for field, identifier := range map[string]string{
+		"source_entity_type_identifier": rel.SourceEntityTypeIdentifier,
+		"target_entity_type_identifier": rel.TargetEntityTypeIdentifier,
+	} {
+		if _, ok := s.entityTypes[identifier]; !ok {
+			errs[field] = fmt.Sprintf("entity type %q does not exist", identifier)
+		}
+	}
+	if err := errs.check(); err != nil {
+		return nil, err
+	}
+	if _, ok := s.relations[rel.Identifier]; ok {
+		return nil, alreadyExists("relation", rel.Identifier)
+	}
+
+	rel.InverseType = inverseRelationTypes[rel.Type]
+	rel.Description = emptyToNil(rel.Description)
+	rel.CreatedAt = s.timestamp()
+	rel.UpdatedAt = rel.CreatedAt
+	s.relations[rel.Identifier] = &rel
+
+	return relationResponse(&rel), nil
+}
+
+func (s *Server) getRelation(req *request) (interface{}, *apiError) {
+	rel, ok := s.relations[req.param("identifier")]
+	if !ok {
+		return nil, notFound("relation")
+	}
+	return relationResponse(rel), nil
+}
+
+// updateRelation changes the type and description of a relation. The cardinality and entity
+// types cannot be changed, and an empty description clears it.
+func (s *Server) updateRelation(req *request) (interface{}, *apiError) {
+	existing, ok := s.relations[req.param("identifier")]
+	if !ok {
+		return nil, notFound("relation")
+	}
+
+	updated := *existing
+	if err := req.decodeField("type", &updated.Type); err != nil {
+		return nil, err
+	}
+	if err := req.decodeField("description", &updated.Description); err != nil {
+		return nil, err
+	}
+
+	errs := fieldErrors{}
+	validateRelationType(updated.Type, errs)
+	if err := errs.check(); err != nil {
+		return nil, err
+	}
+
+	updated.InverseType = inverseRelationTypes[updated.Type]
+	updated.Description = emptyToNil(updated.Description)
+	updated.UpdatedAt = s.timestamp()
+	s.relations[updated.Identifier] = &updated
+
+	return relationResponse(&updated), nil
+}
+
+func (s *Server) deleteRelation(req *request) (interface{}, *apiError) {
+	identifier := req.param("identifier")
+	if _, ok := s.relations[identifier]; !ok {
+		return nil, notFound("relation")
+	}
+	delete(s.relations, identifier)
+	s.removeEntityRelationships(func(edge *entityRelationship) bool {
+		return edge.RelationIdentifier == identifier
+	})
+	return okResponse(), nil
+}
+
+// listRelations returns one page of every relation definition, ordered by identifier.
+func (s *Server) listRelations(req *request) (interface{}, *apiError) {
+	limit, offset, err := pageParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	all := []*relation{}
+	for _, identifier := range sortedKeys(s.relations) {
+		all = append(all, s.relations[identifier])
+	}
+
+	page, nextCursor := paginate(all, limit, offset)
+	return map[string]interface{}{
+		"ok":                true,
+		"relations":         page,
+		"response_metadata": map[string]string{"next_cursor": nextCursor},
+	}, nil
+}
+
+func validateRelationType(relationType string, errs fieldErrors) {
+	if _, ok := inverseRelationTypes[relationType]; !ok {
+		errs["type"] = fmt.Sprintf("unknown relation type %q", relationType)
+	}
+}
+
+func emptyToNil(s *string) *string {
+	if s == nil || *s == "" {
+		return nil
+	}
+	return s
+}
+
+func relationResponse(rel *relation) map[string]interface{} {
+	return map[string]interface{}{"ok": true, "relation": rel}
+}
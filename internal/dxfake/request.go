@@ -0,0 +1,138 @@
+package dxfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+)
+
+// request is a decoded API call. GET endpoints take their parameters from the query string and
+// POST endpoints from a JSON object body, although some POST endpoints also accept the query.
+type request struct {
+	query url.Values
+	body  map[string]json.RawMessage
+}
+
+func parseRequest(r *http.Request) (*request, *apiError) {
+	req := &request{query: r.URL.Query(), body: map[string]json.RawMessage{}}
+	if r.Method != http.MethodPost {
+		return req, nil
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, &apiError{status: http.StatusBadRequest, code: "invalid_request", message: err.Error()}
+	}
+	if len(data) == 0 {
+		return req, nil
+	}
+	if err := json.Unmarshal(data, &req.body); err != nil {
+		return nil, &apiError{status: http.StatusBadRequest, code: "invalid_json", message: err.Error()}
+	}
+	return req, nil
+}
+
+// has reports whether the body contains field, even if its value is null.
+func (r *request) has(field string) bool {
+	_, ok := r.body[field]
+	return ok
+}
+
+// decode unmarshals the whole body into v.
+func (r *request) decode(v interface{}) *apiError {
+	data, err := json.Marshal(r.body)
+	if err == nil {
+		err = json.Unmarshal(data, v)
+	}
+	if err != nil {
+		return &apiError{status: http.StatusBadRequest, code: "invalid_request", message: err.Error()}
+	}
+	return nil
+}
+
+// decodeField unmarshals a single body field into v, leaving v untouched if it is absent.
+func (r *request) decodeField(field string, v interface{}) *apiError {
+	raw, ok := r.body[field]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return validationError(fieldErrors{field: "has an invalid type"})
+	}
+	return nil
+}
+
+// param returns a string parameter from the query string or, failing that, the body.
+func (r *request) param(name string) string {
+	if value := r.query.Get(name); value != "" {
+		return value
+	}
+	var value string
+	_ = r.decodeField(name, &value)
+	return value
+}
+
+// apiError is an error response, in the shape the DX API uses:
+//
+// ```json
+// { "ok": false, "error": "not_found", "details": ... }
+// ```.
+type apiError struct {
+	status  int
+	code    string
+	message string
+	details fieldErrors
+}
+
+// fieldErrors maps request fields, e.g. `checks.0.sql`, to validation messages.
+type fieldErrors map[string]string
+
+func notFound(kind string) *apiError {
+	return &apiError{status: http.StatusNotFound, code: kind + "_not_found"}
+}
+
+func alreadyExists(kind, identifier string) *apiError {
+	return &apiError{
+		status:  http.StatusConflict,
+		code:    kind + "_already_exists",
+		message: fmt.Sprintf("A %s with identifier %q already exists", kind, identifier),
+	}
+}
+
+func validationError(details fieldErrors) *apiError {
+	return &apiError{status: http.StatusBadRequest, code: "validation_failed", details: details}
+}
+
+// check returns a validation error for errs, or nil if it is empty.
+func (errs fieldErrors) check() *apiError {
+	if len(errs) == 0 {
+		return nil
+	}
+	return validationError(errs)
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	body := map[string]interface{}{"ok": false, "error": err.code}
+	if err.message != "" {
+		body["message"] = err.message
+	}
+	if len(err.details) > 0 {
+		body["details"] = err.details
+	}
+
+	data, _ := json.Marshal(body)
+	w.WriteHeader(err.status)
+	_, _ = w.Write(data)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
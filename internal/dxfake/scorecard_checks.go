@@ -0,0 +1,269 @@
+package dxfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+)
+
+// scorecardCheckRequest is the body of scorecards.checks.create and scorecards.checks.update,
+// which manage a single check of an existing scorecard. Unlike scorecards.update, the level or
+// check group is referenced by ID.
+type scorecardCheckRequest struct {
+	check
+
+	ScorecardId           string  `json:"scorecard_id"`
+	ScorecardLevelId      *string `json:"scorecard_level_id"`
+	ScorecardCheckGroupId *string `json:"scorecard_check_group_id"`
+}
+
+func (s *Server) createScorecardCheck(req *request) (interface{}, *apiError) {
+	var body scorecardCheckRequest
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	sc, ok := s.scorecards[body.ScorecardId]
+	if !ok {
+		return nil, notFound("scorecard")
+	}
+
+	chk, err := buildScorecardCheck(sc, &body)
+	if err != nil {
+		return nil, err
+	}
+	chk.Id = s.newID()
+	sc.Checks = append(sc.Checks, chk)
+
+	return scorecardCheckResponse(chk), nil
+}
+
+func (s *Server) getScorecardCheck(req *request) (interface{}, *apiError) {
+	sc, ok := s.scorecards[req.param("scorecard_id")]
+	if !ok {
+		return nil, notFound("scorecard")
+	}
+	i := findCheck(sc, req.param("id"))
+	if i < 0 {
+		return nil, notFound("check")
+	}
+	return scorecardCheckResponse(sc.Checks[i]), nil
+}
+
+// updateScorecardCheck replaces the check with the request, leaving the rest of the scorecard
+// alone.
+func (s *Server) updateScorecardCheck(req *request) (interface{}, *apiError) {
+	var body scorecardCheckRequest
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	sc, ok := s.scorecards[body.ScorecardId]
+	if !ok {
+		return nil, notFound("scorecard")
+	}
+	i := findCheck(sc, body.Id)
+	if i < 0 {
+		return nil, notFound("check")
+	}
+
+	chk, err := buildScorecardCheck(sc, &body)
+	if err != nil {
+		return nil, err
+	}
+	chk.Id = body.Id
+	sc.Checks[i] = chk
+
+	return scorecardCheckResponse(chk), nil
+}
+
+func (s *Server) deleteScorecardCheck(req *request) (interface{}, *apiError) {
+	sc, ok := s.scorecards[req.param("scorecard_id")]
+	if !ok {
+		return nil, notFound("scorecard")
+	}
+	i := findCheck(sc, req.param("id"))
+	if i < 0 {
+		return nil, notFound("check")
+	}
+	sc.Checks = append(sc.Checks[:i], sc.Checks[i+1:]...)
+	s.removeOrphanedExemptions(sc)
+	return okResponse(), nil
+}
+
+// buildScorecardCheck validates a check request and places the check in the level or check
+// group it references.
+func buildScorecardCheck(sc *scorecard, body *scorecardCheckRequest) (*check, *apiError) {
+	errs := fieldErrors{}
+	chk := body.check
+	chk.Level, chk.CheckGroup = nil, nil
+	validateCheck(&chk, sc.Type, "", errs)
+
+	switch sc.Type {
+	case "LEVEL":
+		for _, l := range sc.Levels {
+			if body.ScorecardLevelId != nil && l.Id == *body.ScorecardLevelId {
+				chk.Level = l
+			}
+		}
+		if chk.Level == nil {
+			errs["scorecard_level_id"] = "does not match any level"
+		}
+	case "POINTS":
+		for _, g := range sc.CheckGroups {
+			if body.ScorecardCheckGroupId != nil && g.Id == *body.ScorecardCheckGroupId {
+				chk.CheckGroup = g
+			}
+		}
+		if chk.CheckGroup == nil {
+			errs["scorecard_check_group_id"] = "does not match any check group"
+		}
+	}
+
+	if err := errs.check(); err != nil {
+		return nil, err
+	}
+	return &chk, nil
+}
+
+// findCheck returns the index of the scorecard's check with the given ID, or -1.
+func findCheck(sc *scorecard, id string) int {
+	for i, chk := range sc.Checks {
+		if chk.Id == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func scorecardCheckResponse(chk *check) map[string]interface{} {
+	return map[string]interface{}{"ok": true, "check": chk}
+}
+
+// checkEvaluationRequest is the body of scorecards.checks.evaluate.
+type checkEvaluationRequest struct {
+	Sql           string  `json:"sql"`
+	FilterSql     *string `json:"filter_sql"`
+	OutputEnabled bool    `json:"output_enabled"`
+
+	ScorecardId                 *string  `json:"scorecard_id"`
+	EntityFilterTypeIdentifiers []string `json:"entity_filter_type_identifiers"`
+	EntityFilterSql             *string  `json:"entity_filter_sql"`
+	Limit                       int      `json:"limit"`
+}
+
+var (
+	statusLiteral = regexp.MustCompile(`'(PASS|WARN|FAIL)'`)
+	outputLiteral = regexp.MustCompile(`(?i)(-?\d+(?:\.\d+)?)\s+as\s+output\b`)
+	quotedLiteral = regexp.MustCompile(`'([^']*)'`)
+)
+
+// fakeQuery stands in for running a check's queries, as the fake has no database. It looks at
+// the queries instead: the status is the first 'PASS', 'WARN' or 'FAIL' literal in `sql`, the
+// output is the number aliased `as output`, and a `filter_sql` with string literals keeps only
+// the entities whose identifier is one of them.
+type fakeQuery struct {
+	status string
+	output json.RawMessage
+	kept   map[string]bool
+}
+
+func newFakeQuery(sql string, filterSql *string, outputEnabled bool) fakeQuery {
+	var q fakeQuery
+	if match := statusLiteral.FindStringSubmatch(sql); match != nil {
+		q.status = match[1]
+	}
+	if match := outputLiteral.FindStringSubmatch(sql); outputEnabled && match != nil {
+		q.output = json.RawMessage(match[1])
+	}
+	if filterSql != nil {
+		for _, literal := range quotedLiteral.FindAllStringSubmatch(*filterSql, -1) {
+			if q.kept == nil {
+				q.kept = map[string]bool{}
+			}
+			q.kept[literal[1]] = true
+		}
+	}
+	return q
+}
+
+// excludes reports whether the check's filter leaves out the entity.
+func (q fakeQuery) excludes(e *entity) bool {
+	return q.kept != nil && !q.kept[e.Identifier]
+}
+
+// evaluateScorecardCheck pretends to run a check against a sample of entities, ordered by
+// identifier, see fakeQuery.
+func (s *Server) evaluateScorecardCheck(req *request) (interface{}, *apiError) {
+	var body checkEvaluationRequest
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	errs := fieldErrors{}
+	query := newFakeQuery(body.Sql, body.FilterSql, body.OutputEnabled)
+	if query.status == "" {
+		errs["sql"] = "did not return a status of PASS, WARN or FAIL"
+	}
+	if body.Limit == 0 {
+		body.Limit = 100
+	}
+	if body.Limit < 1 || body.Limit > 1000 {
+		errs["limit"] = "must be between 1 and 1000"
+	}
+
+	entityTypes := body.EntityFilterTypeIdentifiers
+	selections := 0
+	if len(body.EntityFilterTypeIdentifiers) > 0 {
+		selections++
+	}
+	if body.EntityFilterSql != nil {
+		selections++
+	}
+	if body.ScorecardId != nil {
+		selections++
+		if sc, ok := s.scorecards[*body.ScorecardId]; !ok {
+			errs["scorecard_id"] = fmt.Sprintf("scorecard %q does not exist", *body.ScorecardId)
+		} else {
+			entityTypes = sc.EntityFilterTypeIdentifiers
+		}
+	}
+	if selections != 1 {
+		errs["scorecard_id"] = "exactly one of scorecard_id, entity_filter_type_identifiers or entity_filter_sql is required"
+	}
+	for i, identifier := range body.EntityFilterTypeIdentifiers {
+		if _, ok := s.entityTypes[identifier]; !ok {
+			errs[fmt.Sprintf("entity_filter_type_identifiers.%d", i)] = fmt.Sprintf("entity type %q does not exist", identifier)
+		}
+	}
+	if err := errs.check(); err != nil {
+		return nil, err
+	}
+
+	results := []map[string]interface{}{}
+	for _, id := range sortedKeys(s.entities) {
+		e := s.entities[id]
+		if len(results) == body.Limit {
+			break
+		}
+		if len(entityTypes) > 0 && !slices.Contains(entityTypes, e.Type) {
+			continue
+		}
+
+		result := map[string]interface{}{
+			"entity_identifier": e.Identifier,
+			"entity_name":       e.Name,
+			"status":            query.status,
+			"output":            query.output,
+			"message":           nil,
+		}
+		if query.excludes(e) {
+			result["status"], result["output"] = nil, nil
+			result["message"] = "Excluded by the check's filter"
+		}
+		results = append(results, result)
+	}
+
+	return map[string]interface{}{"ok": true, "results": results}, nil
+}
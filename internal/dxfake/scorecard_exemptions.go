@@ -0,0 +1,188 @@
+package dxfake
+
+import (
+	"fmt"
+	"time"
+)
+
+// exemption lets an entity skip a check of a scorecard, until it expires.
+type exemption struct {
+	Id               string  `json:"id"`
+	ScorecardId      string  `json:"scorecard_id"`
+	CheckId          string  `json:"check_id"`
+	EntityIdentifier string  `json:"entity_identifier"`
+	Reason           string  `json:"reason"`
+	ExpiresAt        *string `json:"expires_at"`
+	CreatedAt        string  `json:"created_at"`
+}
+
+func (s *Server) createExemption(req *request) (interface{}, *apiError) {
+	var body exemption
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	errs := fieldErrors{}
+	if sc, ok := s.scorecards[body.ScorecardId]; !ok {
+		errs["scorecard_id"] = fmt.Sprintf("scorecard %q does not exist", body.ScorecardId)
+	} else if findCheck(sc, body.CheckId) < 0 {
+		errs["check_id"] = fmt.Sprintf("scorecard %q has no check %q", body.ScorecardId, body.CheckId)
+	}
+	if _, ok := s.entities[body.EntityIdentifier]; !ok {
+		errs["entity_identifier"] = fmt.Sprintf("entity %q does not exist", body.EntityIdentifier)
+	}
+	s.validateExemption(&body, errs)
+	if err := errs.check(); err != nil {
+		return nil, err
+	}
+
+	for _, existing := range s.exemptions {
+		if existing.ScorecardId == body.ScorecardId && existing.CheckId == body.CheckId && existing.EntityIdentifier == body.EntityIdentifier {
+			return nil, alreadyExists("exemption", fmt.Sprintf("%s:%s:%s", body.ScorecardId, body.CheckId, body.EntityIdentifier))
+		}
+	}
+
+	body.Id = s.newID()
+	body.CreatedAt = s.timestamp()
+	s.exemptions = append(s.exemptions, &body)
+
+	return exemptionResponse(&body), nil
+}
+
+// getExemption returns an exemption, even after it expired.
+func (s *Server) getExemption(req *request) (interface{}, *apiError) {
+	i := s.findExemption(req.param("id"))
+	if i < 0 {
+		return nil, notFound("exemption")
+	}
+	return exemptionResponse(s.exemptions[i]), nil
+}
+
+// updateExemption changes the reason and expiry. The exempted check and entity cannot change.
+func (s *Server) updateExemption(req *request) (interface{}, *apiError) {
+	i := s.findExemption(req.param("id"))
+	if i < 0 {
+		return nil, notFound("exemption")
+	}
+
+	updated := *s.exemptions[i]
+	if err := req.decodeField("reason", &updated.Reason); err != nil {
+		return nil, err
+	}
+	if req.has("expires_at") {
+		updated.ExpiresAt = nil
+		if err := req.decodeField("expires_at", &updated.ExpiresAt); err != nil {
+			return nil, err
+		}
+	}
+	errs := fieldErrors{}
+	s.validateExemption(&updated, errs)
+	if err := errs.check(); err != nil {
+		return nil, err
+	}
+
+	s.exemptions[i] = &updated
+	return exemptionResponse(&updated), nil
+}
+
+func (s *Server) deleteExemption(req *request) (interface{}, *apiError) {
+	i := s.findExemption(req.param("id"))
+	if i < 0 {
+		return nil, notFound("exemption")
+	}
+	s.exemptions = append(s.exemptions[:i], s.exemptions[i+1:]...)
+	return okResponse(), nil
+}
+
+// listExemptions returns one page of the active exemptions matching every given filter, in
+// creation order. Expired exemptions are left out.
+func (s *Server) listExemptions(req *request) (interface{}, *apiError) {
+	limit, offset, err := pageParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	scorecardId := req.query.Get("scorecard_id")
+	checkId := req.query.Get("check_id")
+	entityIdentifier := req.query.Get("entity_identifier")
+
+	matches := []*exemption{}
+	for _, ex := range s.exemptions {
+		if (scorecardId != "" && ex.ScorecardId != scorecardId) ||
+			(checkId != "" && ex.CheckId != checkId) ||
+			(entityIdentifier != "" && ex.EntityIdentifier != entityIdentifier) ||
+			!s.exemptionActive(ex) {
+			continue
+		}
+		matches = append(matches, ex)
+	}
+
+	page, nextCursor := paginate(matches, limit, offset)
+	return map[string]interface{}{
+		"ok":                true,
+		"exemptions":        page,
+		"response_metadata": map[string]string{"next_cursor": nextCursor},
+	}, nil
+}
+
+// validateExemption checks the fields of an exemption that can be updated, and converts the
+// expiry to UTC. A new expiry must be in the future.
+func (s *Server) validateExemption(ex *exemption, errs fieldErrors) {
+	if ex.Reason == "" {
+		errs["reason"] = "is required"
+	}
+	if ex.ExpiresAt != nil {
+		expiresAt, err := time.Parse(time.RFC3339, *ex.ExpiresAt)
+		switch {
+		case err != nil:
+			errs["expires_at"] = "must be an RFC 3339 timestamp"
+		case !expiresAt.After(s.now()):
+			errs["expires_at"] = "must be in the future"
+		default:
+			utc := expiresAt.UTC().Format(time.RFC3339)
+			ex.ExpiresAt = &utc
+		}
+	}
+}
+
+func (s *Server) exemptionActive(ex *exemption) bool {
+	if ex.ExpiresAt == nil {
+		return true
+	}
+	expiresAt, err := time.Parse(time.RFC3339, *ex.ExpiresAt)
+	return err == nil && expiresAt.After(s.now())
+}
+
+// findExemption returns the index of the exemption with the given ID, or -1.
+func (s *Server) findExemption(id string) int {
+	for i, ex := range s.exemptions {
+		if ex.Id == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// removeExemptions drops the exemptions for which drop returns true. It keeps exemptions
+// consistent when the scorecards, checks or entities they reference are deleted.
+func (s *Server) removeExemptions(drop func(ex *exemption) bool) {
+	kept := s.exemptions[:0]
+	for _, ex := range s.exemptions {
+		if !drop(ex) {
+			kept = append(kept, ex)
+		}
+	}
+	s.exemptions = kept
+}
+
+// removeOrphanedExemptions drops the exemptions of checks that are no longer part of their
+// scorecard, e.g. after a scorecard update removed them.
+func (s *Server) removeOrphanedExemptions(sc *scorecard) {
+	s.removeExemptions(func(ex *exemption) bool {
+		return ex.ScorecardId == sc.Id && findCheck(sc, ex.CheckId) < 0
+	})
+}
+
+func exemptionResponse(ex *exemption) map[string]interface{} {
+	return map[string]interface{}{"ok": true, "exemption": ex}
+}
@@ -0,0 +1,127 @@
+package dxfake
+
+import (
+	"encoding/json"
+	"slices"
+	"sort"
+)
+
+// scorecardResult is the current outcome of a scorecard for one entity.
+type scorecardResult struct {
+	EntityIdentifier string  `json:"entity_identifier"`
+	EntityName       *string `json:"entity_name"`
+	EntityType       string  `json:"entity_type"`
+	// Level is the highest level reached, or nil when none is (levels scorecards only).
+	Level *level `json:"level"`
+	// Points and PointsPossible are set for points scorecards only.
+	Points         *int          `json:"points"`
+	PointsPossible *int          `json:"points_possible"`
+	CheckResults   []checkResult `json:"check_results"`
+}
+
+type checkResult struct {
+	CheckId   string          `json:"check_id"`
+	CheckName string          `json:"check_name"`
+	Status    *string         `json:"status"`
+	Output    json.RawMessage `json:"output"`
+}
+
+// listScorecardResults returns one page of a scorecard's results, ordered by entity identifier.
+// Checks are evaluated on every request, see fakeQuery. A check without a status literal fails,
+// and WARN counts as passing towards levels and points, like a real evaluation.
+func (s *Server) listScorecardResults(req *request) (interface{}, *apiError) {
+	limit, offset, err := pageParams(req)
+	if err != nil {
+		return nil, err
+	}
+	sc, ok := s.scorecards[req.param("scorecard_id")]
+	if !ok {
+		return nil, notFound("scorecard")
+	}
+	identifier := req.param("entity_identifier")
+	if _, ok := s.entities[identifier]; identifier != "" && !ok {
+		return nil, notFound("entity")
+	}
+
+	// The entities a sql filter selects are the identifiers it quotes
+	entityQuery := fakeQuery{}
+	if sc.EntityFilterType == "sql" && sc.EntityFilterSql != nil {
+		entityQuery = newFakeQuery("", sc.EntityFilterSql, false)
+	}
+
+	all := []*scorecardResult{}
+	for _, id := range sortedKeys(s.entities) {
+		e := s.entities[id]
+		if identifier != "" && e.Identifier != identifier {
+			continue
+		}
+		if sc.EntityFilterType == "entity_types" && !slices.Contains(sc.EntityFilterTypeIdentifiers, e.Type) {
+			continue
+		}
+		if entityQuery.excludes(e) {
+			continue
+		}
+		all = append(all, evaluateScorecard(sc, e))
+	}
+
+	page, nextCursor := paginate(all, limit, offset)
+	return map[string]interface{}{
+		"ok":                true,
+		"results":           page,
+		"response_metadata": map[string]string{"next_cursor": nextCursor},
+	}, nil
+}
+
+func evaluateScorecard(sc *scorecard, e *entity) *scorecardResult {
+	result := &scorecardResult{
+		EntityIdentifier: e.Identifier,
+		EntityName:       e.Name,
+		EntityType:       e.Type,
+		CheckResults:     []checkResult{},
+	}
+
+	// failedRanks holds the ranks of the levels with a failing check
+	failedRanks := map[int]bool{}
+	points, possible := 0, 0
+	for _, chk := range sc.Checks {
+		query := newFakeQuery(chk.Sql, chk.FilterSql, chk.OutputEnabled)
+		cr := checkResult{CheckId: chk.Id, CheckName: chk.Name}
+		if !query.excludes(e) {
+			status := query.status
+			if status == "" {
+				status = "FAIL"
+			}
+			cr.Status, cr.Output = &status, query.output
+		}
+		result.CheckResults = append(result.CheckResults, cr)
+		if cr.Status == nil {
+			continue
+		}
+
+		passed := *cr.Status != "FAIL"
+		if chk.Level != nil && !passed {
+			failedRanks[chk.Level.Rank] = true
+		}
+		if chk.Points != nil {
+			possible += *chk.Points
+			if passed {
+				points += *chk.Points
+			}
+		}
+	}
+
+	switch sc.Type {
+	case "LEVEL":
+		levels := slices.Clone(sc.Levels)
+		sort.Slice(levels, func(i, j int) bool { return levels[i].Rank < levels[j].Rank })
+		for _, l := range levels {
+			if failedRanks[l.Rank] {
+				break
+			}
+			result.Level = l
+		}
+	case "POINTS":
+		result.Points, result.PointsPossible = &points, &possible
+	}
+	return result
+}
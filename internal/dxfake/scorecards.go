@@ -0,0 +1,331 @@
+package dxfake
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type scorecard struct {
+	Id                          string        `json:"id"`
+	Name                        string        `json:"name"`
+	Description                 *string       `json:"description"`
+	Type                        string        `json:"type"`
+	EntityFilterType            string        `json:"entity_filter_type"`
+	EntityFilterTypeIdentifiers []string      `json:"entity_filter_type_identifiers"`
+	EntityFilterSql             *string       `json:"entity_filter_sql"`
+	EvaluationFrequency         int           `json:"evaluation_frequency_hours"`
+	EmptyLevelLabel             *string       `json:"empty_level_label"`
+	EmptyLevelColor             *string       `json:"empty_level_color"`
+	Published                   bool          `json:"published"`
+	Tags                        []tag         `json:"tags"`
+	Levels                      []*level      `json:"levels"`
+	CheckGroups                 []*checkGroup `json:"check_groups"`
+	Checks                      []*check      `json:"checks"`
+}
+
+type tag struct {
+	Value string `json:"value"`
+}
+
+type level struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+	Rank  int    `json:"rank"`
+}
+
+type checkGroup struct {
+	Id       string `json:"id"`
+	Name     string `json:"name"`
+	Ordering int    `json:"ordering"`
+}
+
+type check struct {
+	Id                  string               `json:"id"`
+	Name                string               `json:"name"`
+	Description         *string              `json:"description"`
+	Ordering            int                  `json:"ordering"`
+	Sql                 string               `json:"sql"`
+	FilterSql           *string              `json:"filter_sql"`
+	FilterMessage       *string              `json:"filter_message"`
+	OutputEnabled       bool                 `json:"output_enabled"`
+	OutputType          *string              `json:"output_type"`
+	OutputAggregation   *string              `json:"output_aggregation"`
+	OutputCustomOptions *outputCustomOptions `json:"output_custom_options"`
+	EstimatedDevDays    *float64             `json:"estimated_dev_days"`
+	ExternalUrl         *string              `json:"external_url"`
+	Published           bool                 `json:"published"`
+	Level               *level               `json:"level"`
+	CheckGroup          *checkGroup          `json:"check_group"`
+	Points              *int                 `json:"points"`
+}
+
+type outputCustomOptions struct {
+	Unit string `json:"unit"`
+	// Decimals is a number, or the string "auto".
+	Decimals json.RawMessage `json:"decimals"`
+}
+
+// scorecardRequest is the body of scorecards.create and scorecards.update. Levels and check
+// groups are referenced from checks by a key that is only meaningful within the request.
+type scorecardRequest struct {
+	scorecard
+
+	Levels []struct {
+		level
+		Key string `json:"key"`
+	} `json:"levels"`
+	CheckGroups []struct {
+		checkGroup
+		Key string `json:"key"`
+	} `json:"check_groups"`
+	Checks []struct {
+		check
+		ScorecardLevelKey      *string `json:"scorecard_level_key"`
+		ScorecardCheckGroupKey *string `json:"scorecard_check_group_key"`
+	} `json:"checks"`
+}
+
+var evaluationFrequencies = map[int]bool{2: true, 4: true, 8: true, 24: true}
+
+func (s *Server) createScorecard(req *request) (interface{}, *apiError) {
+	var body scorecardRequest
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	sc, err := s.buildScorecard(&body, nil)
+	if err != nil {
+		return nil, err
+	}
+	sc.Id = s.newID()
+	s.scorecards[sc.Id] = sc
+
+	return scorecardResponse(sc), nil
+}
+
+func (s *Server) getScorecard(req *request) (interface{}, *apiError) {
+	sc, ok := s.scorecards[req.param("id")]
+	if !ok {
+		return nil, notFound("scorecard")
+	}
+	return scorecardResponse(sc), nil
+}
+
+// updateScorecard replaces the scorecard with the request. Levels, check groups and checks keep
+// their ID when the request includes it, and are created when it does not.
+func (s *Server) updateScorecard(req *request) (interface{}, *apiError) {
+	existing, ok := s.scorecards[req.param("id")]
+	if !ok {
+		return nil, notFound("scorecard")
+	}
+
+	var body scorecardRequest
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	sc, err := s.buildScorecard(&body, existing)
+	if err != nil {
+		return nil, err
+	}
+	sc.Id = existing.Id
+	s.scorecards[sc.Id] = sc
+	s.removeOrphanedExemptions(sc)
+
+	return scorecardResponse(sc), nil
+}
+
+func (s *Server) deleteScorecard(req *request) (interface{}, *apiError) {
+	id := req.param("id")
+	if _, ok := s.scorecards[id]; !ok {
+		return nil, notFound("scorecard")
+	}
+	delete(s.scorecards, id)
+	s.removeExemptions(func(ex *exemption) bool {
+		return ex.ScorecardId == id
+	})
+	return okResponse(), nil
+}
+
+// listScorecards returns one page of every scorecard, ordered by ID.
+func (s *Server) listScorecards(req *request) (interface{}, *apiError) {
+	limit, offset, err := pageParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := sortedKeys(s.scorecards)
+	all := make([]*scorecard, 0, len(ids))
+	for _, id := range ids {
+		all = append(all, s.scorecards[id])
+	}
+
+	page, nextCursor := paginate(all, limit, offset)
+	return map[string]interface{}{
+		"ok":                true,
+		"scorecards":        page,
+		"response_metadata": map[string]string{"next_cursor": nextCursor},
+	}, nil
+}
+
+// buildScorecard validates a create or update request and converts it to the stored scorecard.
+// existing is the scorecard being updated, or nil on create.
+func (s *Server) buildScorecard(body *scorecardRequest, existing *scorecard) (*scorecard, *apiError) {
+	errs := fieldErrors{}
+	sc := body.scorecard
+	sc.Levels = []*level{}
+	sc.CheckGroups = []*checkGroup{}
+	sc.Checks = []*check{}
+	if sc.Tags == nil {
+		sc.Tags = []tag{}
+	}
+	if sc.EntityFilterTypeIdentifiers == nil {
+		sc.EntityFilterTypeIdentifiers = []string{}
+	}
+	sc.EntityFilterSql = emptyToNil(sc.EntityFilterSql)
+
+	if sc.Name == "" {
+		errs["name"] = "is required"
+	}
+	if !evaluationFrequencies[sc.EvaluationFrequency] {
+		errs["evaluation_frequency_hours"] = "must be one of 2, 4, 8 or 24"
+	}
+
+	switch sc.EntityFilterType {
+	case "entity_types":
+		if len(sc.EntityFilterTypeIdentifiers) == 0 {
+			errs["entity_filter_type_identifiers"] = "is required when entity_filter_type is entity_types"
+		}
+		for i, identifier := range sc.EntityFilterTypeIdentifiers {
+			if _, ok := s.entityTypes[identifier]; !ok {
+				errs[fmt.Sprintf("entity_filter_type_identifiers.%d", i)] = fmt.Sprintf("entity type %q does not exist", identifier)
+			}
+		}
+	case "sql":
+		if sc.EntityFilterSql == nil {
+			errs["entity_filter_sql"] = "is required when entity_filter_type is sql"
+		}
+	default:
+		errs["entity_filter_type"] = "must be entity_types or sql"
+	}
+
+	var existingLevels, existingGroups, existingChecks map[string]bool
+	if existing != nil {
+		existingLevels, existingGroups, existingChecks = map[string]bool{}, map[string]bool{}, map[string]bool{}
+		for _, l := range existing.Levels {
+			existingLevels[l.Id] = true
+		}
+		for _, g := range existing.CheckGroups {
+			existingGroups[g.Id] = true
+		}
+		for _, c := range existing.Checks {
+			existingChecks[c.Id] = true
+		}
+	}
+
+	levelsByKey := map[string]*level{}
+	groupsByKey := map[string]*checkGroup{}
+	switch sc.Type {
+	case "LEVEL":
+		if sc.EmptyLevelLabel == nil || *sc.EmptyLevelLabel == "" {
+			errs["empty_level_label"] = "is required for LEVEL scorecards"
+		}
+		if sc.EmptyLevelColor == nil || *sc.EmptyLevelColor == "" {
+			errs["empty_level_color"] = "is required for LEVEL scorecards"
+		}
+		if len(body.Levels) == 0 {
+			errs["levels"] = "at least one level is required for LEVEL scorecards"
+		}
+		for i, l := range body.Levels {
+			field := fmt.Sprintf("levels.%d", i)
+			if l.Name == "" {
+				errs[field+".name"] = "is required"
+			}
+			lvl := l.level
+			lvl.Id = s.assignID(lvl.Id, existingLevels, field, errs)
+			levelsByKey[l.Key] = &lvl
+			sc.Levels = append(sc.Levels, &lvl)
+		}
+	case "POINTS":
+		if len(body.CheckGroups) == 0 {
+			errs["check_groups"] = "at least one check group is required for POINTS scorecards"
+		}
+		for i, g := range body.CheckGroups {
+			field := fmt.Sprintf("check_groups.%d", i)
+			if g.Name == "" {
+				errs[field+".name"] = "is required"
+			}
+			grp := g.checkGroup
+			grp.Id = s.assignID(grp.Id, existingGroups, field, errs)
+			groupsByKey[g.Key] = &grp
+			sc.CheckGroups = append(sc.CheckGroups, &grp)
+		}
+	default:
+		errs["type"] = "must be LEVEL or POINTS"
+	}
+
+	for i, c := range body.Checks {
+		field := fmt.Sprintf("checks.%d", i)
+		chk := c.check
+		validateCheck(&chk, sc.Type, field+".", errs)
+
+		switch sc.Type {
+		case "LEVEL":
+			if c.ScorecardLevelKey == nil || levelsByKey[*c.ScorecardLevelKey] == nil {
+				errs[field+".scorecard_level_key"] = "does not match any level"
+			} else {
+				chk.Level = levelsByKey[*c.ScorecardLevelKey]
+			}
+		case "POINTS":
+			if c.ScorecardCheckGroupKey == nil || groupsByKey[*c.ScorecardCheckGroupKey] == nil {
+				errs[field+".scorecard_check_group_key"] = "does not match any check group"
+			} else {
+				chk.CheckGroup = groupsByKey[*c.ScorecardCheckGroupKey]
+			}
+		}
+
+		chk.Id = s.assignID(chk.Id, existingChecks, field, errs)
+		sc.Checks = append(sc.Checks, &chk)
+	}
+
+	if err := errs.check(); err != nil {
+		return nil, err
+	}
+	return &sc, nil
+}
+
+// validateCheck validates the fields of a check that do not depend on the rest of the request.
+// field prefixes the names of invalid fields.
+func validateCheck(chk *check, scorecardType, field string, errs fieldErrors) {
+	if chk.Name == "" {
+		errs[field+"name"] = "is required"
+	}
+	if chk.Sql == "" {
+		errs[field+"sql"] = "is required"
+	}
+	if chk.OutputEnabled && (chk.OutputType == nil || *chk.OutputType == "") {
+		errs[field+"output_type"] = "is required when output is enabled"
+	}
+	if chk.OutputType != nil && *chk.OutputType == "custom" && chk.OutputCustomOptions == nil {
+		errs[field+"output_custom_options"] = "is required when output_type is custom"
+	}
+	if scorecardType == "POINTS" && (chk.Points == nil || *chk.Points < 0) {
+		errs[field+"points"] = "must be zero or more"
+	}
+}
+
+// assignID returns id if it belongs to an existing item, or a new ID if id is empty.
+func (s *Server) assignID(id string, existing map[string]bool, field string, errs fieldErrors) string {
+	if id == "" {
+		return s.newID()
+	}
+	if !existing[id] {
+		errs[field+".id"] = fmt.Sprintf("%q does not exist", id)
+	}
+	return id
+}
+
+func scorecardResponse(sc *scorecard) map[string]interface{} {
+	return map[string]interface{}{"ok": true, "scorecard": sc}
+}
@@ -0,0 +1,228 @@
+// Package dxfake is an in-memory stand-in for the DX Web API. It lets the provider's acceptance
+// tests run the full resource lifecycle without a DX account or network access.
+//
+// The fake mirrors the API's wire format, validation, ID assignment and error responses closely
+// enough for the provider, but it is not a specification of the API. When the two disagree, the
+// real API wins and the fake should be fixed.
+package dxfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Token is the only API token the fake accepts.
+const Token = "dxfake-token"
+
+// Team is a DX team that entities can be owned by.
+type Team struct {
+	Id       string  `json:"id"`
+	Name     string  `json:"name"`
+	ParentId *string `json:"parent_id,omitempty"`
+}
+
+// User is a DX user that entities can be owned by.
+type User struct {
+	Id    string `json:"id"`
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email"`
+}
+
+// Server is an in-memory DX Web API. It is safe for concurrent use.
+type Server struct {
+	mu sync.Mutex
+
+	entityTypes map[string]*entityType
+	entities    map[string]*entity
+	relations   map[string]*relation
+	scorecards  map[string]*scorecard
+	teams       map[string]Team
+	users       map[string]User
+
+	entityRelationships []*entityRelationship
+	exemptions          []*exemption
+
+	nextID int
+	now    func() time.Time
+}
+
+// New returns a fake seeded like a new DX account, which comes with a built-in `service`
+// entity type, and with the teams and users of a small organization.
+func New() *Server {
+	s := &Server{
+		entityTypes: map[string]*entityType{},
+		entities:    map[string]*entity{},
+		relations:   map[string]*relation{},
+		scorecards:  map[string]*scorecard{},
+		teams:       map[string]Team{},
+		users:       map[string]User{},
+		now:         time.Now,
+	}
+	s.seed()
+	return s
+}
+
+// AddTeam makes a team available as an entity owner.
+func (s *Server) AddTeam(team Team) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.teams[team.Id] = team
+}
+
+// AddUser makes a user available as an entity owner.
+func (s *Server) AddUser(user User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users[user.Id] = user
+}
+
+func (s *Server) seed() {
+	stringPtr := func(s string) *string { return &s }
+	int64Ptr := func(i int64) *int64 { return &i }
+	timestamp := s.timestamp()
+
+	s.entityTypes["service"] = &entityType{
+		Identifier:  "service",
+		Name:        "Service",
+		Description: stringPtr("A deployable software service."),
+		Ordering:    0,
+		Properties: []property{
+			{Identifier: "tier", Name: "Tier", Type: "text", Visibility: stringPtr("visible"), Ordering: int64Ptr(0), Definition: map[string]interface{}{}},
+			{Identifier: "slack-team", Name: "Slack Team", Type: "url", Visibility: stringPtr("visible"), Ordering: int64Ptr(1), Definition: map[string]interface{}{}},
+			{Identifier: "language", Name: "Language", Type: "multi_select", Visibility: stringPtr("visible"), Ordering: int64Ptr(2), Definition: map[string]interface{}{
+				"options": []interface{}{
+					map[string]interface{}{"value": "Go", "color": "#00add8"},
+					map[string]interface{}{"value": "TypeScript", "color": "#3178c6"},
+					map[string]interface{}{"value": "Python", "color": "#3776ab"},
+				},
+			}},
+			{Identifier: "environment", Name: "Environment", Type: "text", Visibility: stringPtr("visible"), Ordering: int64Ptr(3), Definition: map[string]interface{}{}},
+		},
+		Aliases:   map[string]bool{"github_repo": true},
+		CreatedAt: timestamp,
+		UpdatedAt: timestamp,
+	}
+
+	engineering := Team{Id: s.newID(), Name: "Engineering"}
+	s.teams[engineering.Id] = engineering
+	for _, name := range []string{"Developer Experience", "Payments"} {
+		team := Team{Id: s.newID(), Name: name, ParentId: stringPtr(engineering.Id)}
+		s.teams[team.Id] = team
+	}
+	for _, user := range []User{{Name: "Ada Lovelace", Email: "ada@example.com"}, {Name: "Grace Hopper", Email: "grace@example.com"}} {
+		user.Id = s.newID()
+		s.users[user.Id] = user
+	}
+}
+
+// route is a handler for a single API endpoint. It is called with the server lock held.
+type route struct {
+	method  string
+	handler func(s *Server, req *request) (interface{}, *apiError)
+}
+
+var routes = map[string]route{
+	"entityTypes.create": {http.MethodPost, (*Server).createEntityType},
+	"entityTypes.info":   {http.MethodGet, (*Server).getEntityType},
+	"entityTypes.update": {http.MethodPost, (*Server).updateEntityType},
+	"entityTypes.delete": {http.MethodPost, (*Server).deleteEntityType},
+	"entityTypes.list":   {http.MethodGet, (*Server).listEntityTypes},
+
+	"entities.create": {http.MethodPost, (*Server).createEntity},
+	"entities.info":   {http.MethodGet, (*Server).getEntity},
+	"entities.update": {http.MethodPost, (*Server).updateEntity},
+	"entities.delete": {http.MethodPost, (*Server).deleteEntity},
+	"entities.list":   {http.MethodGet, (*Server).listEntities},
+
+	"catalog.relations.create": {http.MethodPost, (*Server).createRelation},
+	"catalog.relations.info":   {http.MethodGet, (*Server).getRelation},
+	"catalog.relations.update": {http.MethodPost, (*Server).updateRelation},
+	"catalog.relations.delete": {http.MethodPost, (*Server).deleteRelation},
+	"catalog.relations.list":   {http.MethodGet, (*Server).listRelations},
+
+	"catalog.entityRelationships.create": {http.MethodPost, (*Server).createEntityRelationship},
+	"catalog.entityRelationships.info":   {http.MethodGet, (*Server).getEntityRelationship},
+	"catalog.entityRelationships.delete": {http.MethodPost, (*Server).deleteEntityRelationship},
+	"catalog.entityRelationships.list":   {http.MethodGet, (*Server).listEntityRelationships},
+
+	"scorecards.create": {http.MethodPost, (*Server).createScorecard},
+	"scorecards.info":   {http.MethodGet, (*Server).getScorecard},
+	"scorecards.update": {http.MethodPost, (*Server).updateScorecard},
+	"scorecards.delete": {http.MethodPost, (*Server).deleteScorecard},
+	"scorecards.list":   {http.MethodGet, (*Server).listScorecards},
+
+	// The acceptance tests of the check, preview, results and exemption endpoints make the same
+	// assertions against a real account when DX_WEB_API_TOKEN is set, so the fake can be checked
+	// against the API by running them there.
+	"scorecards.checks.create":   {http.MethodPost, (*Server).createScorecardCheck},
+	"scorecards.checks.info":     {http.MethodGet, (*Server).getScorecardCheck},
+	"scorecards.checks.update":   {http.MethodPost, (*Server).updateScorecardCheck},
+	"scorecards.checks.delete":   {http.MethodPost, (*Server).deleteScorecardCheck},
+	"scorecards.checks.evaluate": {http.MethodPost, (*Server).evaluateScorecardCheck},
+	"scorecards.results.list":    {http.MethodGet, (*Server).listScorecardResults},
+
+	"scorecards.checks.exemptions.create": {http.MethodPost, (*Server).createExemption},
+	"scorecards.checks.exemptions.info":   {http.MethodGet, (*Server).getExemption},
+	"scorecards.checks.exemptions.update": {http.MethodPost, (*Server).updateExemption},
+	"scorecards.checks.exemptions.delete": {http.MethodPost, (*Server).deleteExemption},
+	"scorecards.checks.exemptions.list":   {http.MethodGet, (*Server).listExemptions},
+
+	"teams.list": {http.MethodGet, (*Server).listTeams},
+	"users.list": {http.MethodGet, (*Server).listUsers},
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	requestID := fmt.Sprintf("dxfake-%d", time.Now().UnixNano())
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-Id", requestID)
+
+	if r.Header.Get("Authorization") != "Bearer "+Token {
+		writeError(w, &apiError{status: http.StatusUnauthorized, code: "invalid_auth"})
+		return
+	}
+
+	rt, ok := routes[strings.TrimPrefix(r.URL.Path, "/")]
+	if !ok {
+		writeError(w, &apiError{status: http.StatusNotFound, code: "unknown_method"})
+		return
+	}
+	if r.Method != rt.method {
+		writeError(w, &apiError{status: http.StatusMethodNotAllowed, code: "method_not_allowed"})
+		return
+	}
+
+	req, err := parseRequest(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	s.mu.Lock()
+	result, err := rt.handler(s, req)
+	s.mu.Unlock()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	body, marshalErr := json.Marshal(result)
+	if marshalErr != nil {
+		writeError(w, &apiError{status: http.StatusInternalServerError, code: "internal_error", message: marshalErr.Error()})
+		return
+	}
+	_, _ = w.Write(body)
+}
+
+// newID returns a new unique ID, in the short alphanumeric format DX uses.
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("fk%06d", s.nextID)
+}
+
+func (s *Server) timestamp() string {
+	return s.now().UTC().Format(time.RFC3339)
+}
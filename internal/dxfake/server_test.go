@@ -0,0 +1,269 @@
+package dxfake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"terraform-provider-dx/dx/dxapi"
+)
+
+func newTestClient(t *testing.T, token string) (*dxapi.Client, *Server) {
+	fake := New()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	return dxapi.NewClient(server.URL, token, "test", dxapi.WithRetry(dxapi.RetryConfig{})), fake
+}
+
+func TestRejectsInvalidToken(t *testing.T) {
+	client, _ := newTestClient(t, "wrong-token")
+
+	if _, err := client.GetEntityType(context.Background(), "service"); !dxapi.IsUnauthorized(err) {
+		t.Errorf("Expected an unauthorized error, got: %v", err)
+	}
+}
+
+func TestEntityLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client, fake := newTestClient(t, Token)
+	fake.AddTeam(Team{Id: "team-1", Name: "Platform"})
+
+	created, err := client.CreateEntity(ctx, map[string]interface{}{
+		"identifier":     "checkout",
+		"type":           "service",
+		"name":           "Checkout",
+		"owner_team_ids": []string{"team-1"},
+		"properties":     map[string]interface{}{"tier": "Tier-1", "language": []string{"Go"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateEntity failed: %s", err)
+	}
+	if created.Entity.Id == "" || len(created.Entity.OwnerTeams) != 1 || created.Entity.OwnerTeams[0].Name != "Platform" {
+		t.Errorf("Expected an ID and resolved owner team, got %+v", created.Entity)
+	}
+
+	if _, err := client.CreateEntity(ctx, map[string]interface{}{"identifier": "checkout", "type": "service"}); !dxapi.IsConflict(err) {
+		t.Errorf("Expected a conflict for a duplicate identifier, got: %v", err)
+	}
+
+	updated, err := client.UpdateEntity(ctx, map[string]interface{}{
+		"identifier": "checkout",
+		"properties": map[string]interface{}{"tier": nil, "environment": "production"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateEntity failed: %s", err)
+	}
+	if _, ok := updated.Entity.Properties["tier"]; ok || updated.Entity.Properties["environment"] != "production" || *updated.Entity.Name != "Checkout" {
+		t.Errorf("Expected properties to be merged and other fields kept, got %+v", updated.Entity)
+	}
+
+	if _, err := client.DeleteEntity(ctx, "checkout"); err != nil {
+		t.Fatalf("DeleteEntity failed: %s", err)
+	}
+	if _, err := client.GetEntity(ctx, "checkout"); !dxapi.IsNotFound(err) {
+		t.Errorf("Expected the deleted entity to be not found, got: %v", err)
+	}
+}
+
+func TestEntityValidation(t *testing.T) {
+	client, _ := newTestClient(t, Token)
+
+	_, err := client.CreateEntity(context.Background(), map[string]interface{}{
+		"identifier": "checkout",
+		"type":       "service",
+		"properties": map[string]interface{}{"language": []string{"COBOL"}, "unknown": "value"},
+	})
+
+	var apiErr *dxapi.APIError
+	if !errors.As(err, &apiErr) || !dxapi.IsValidation(err) {
+		t.Fatalf("Expected a validation error, got: %v", err)
+	}
+	fields := map[string]bool{}
+	for _, fieldErr := range apiErr.FieldErrors {
+		fields[fieldErr.Field] = true
+	}
+	if !fields["properties.language"] || !fields["properties.unknown"] {
+		t.Errorf("Expected errors for both invalid properties, got %+v", apiErr.FieldErrors)
+	}
+}
+
+func TestListEntitiesPaginates(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t, Token)
+	for i := 0; i < 7; i++ {
+		if _, err := client.CreateEntity(ctx, map[string]interface{}{"identifier": fmt.Sprintf("svc-%d", i), "type": "service"}); err != nil {
+			t.Fatalf("CreateEntity failed: %s", err)
+		}
+	}
+
+	entities, err := client.ListEntities(ctx, "service", &dxapi.ListEntitiesOptions{PageOptions: dxapi.PageOptions{PageSize: 3}})
+	if err != nil {
+		t.Fatalf("ListEntities failed: %s", err)
+	}
+	if len(entities) != 7 || entities[0].Identifier != "svc-0" || entities[6].Identifier != "svc-6" {
+		t.Errorf("Expected all 7 entities in identifier order, got %d", len(entities))
+	}
+}
+
+func TestEntityTypeInUseCannotBeDeleted(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t, Token)
+	if _, err := client.CreateEntity(ctx, map[string]interface{}{"identifier": "checkout", "type": "service"}); err != nil {
+		t.Fatalf("CreateEntity failed: %s", err)
+	}
+
+	if _, err := client.DeleteEntityType(ctx, "service"); !dxapi.IsConflict(err) {
+		t.Errorf("Expected a conflict deleting an entity type in use, got: %v", err)
+	}
+}
+
+func TestScorecardUpdateKeepsIDs(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t, Token)
+
+	scorecard := map[string]interface{}{
+		"name":                           "Production readiness",
+		"type":                           "LEVEL",
+		"entity_filter_type":             "entity_types",
+		"entity_filter_type_identifiers": []string{"service"},
+		"evaluation_frequency_hours":     2,
+		"empty_level_label":              "None",
+		"empty_level_color":              "#cccccc",
+		"levels":                         []map[string]interface{}{{"key": "bronze", "name": "Bronze", "color": "#cd7f32", "rank": 1}},
+		"checks": []map[string]interface{}{{
+			"name": "Has owner", "sql": "select 'PASS' as status", "ordering": 0, "scorecard_level_key": "bronze",
+		}},
+	}
+	created, err := client.CreateScorecard(ctx, scorecard)
+	if err != nil {
+		t.Fatalf("CreateScorecard failed: %s", err)
+	}
+	check := created.Scorecard.Checks[0]
+	if check.Level == nil || *check.Level.Id != *created.Scorecard.Levels[0].Id {
+		t.Fatalf("Expected the check to reference its level, got %+v", check.Level)
+	}
+
+	scorecard["id"] = created.Scorecard.Id
+	scorecard["levels"].([]map[string]interface{})[0]["id"] = *created.Scorecard.Levels[0].Id
+	scorecard["checks"].([]map[string]interface{})[0]["id"] = *check.Id
+	scorecard["checks"] = append(scorecard["checks"].([]map[string]interface{}), map[string]interface{}{
+		"name": "Has docs", "sql": "select 'PASS' as status", "ordering": 1, "scorecard_level_key": "bronze",
+	})
+	updated, err := client.UpdateScorecard(ctx, scorecard)
+	if err != nil {
+		t.Fatalf("UpdateScorecard failed: %s", err)
+	}
+	if *updated.Scorecard.Checks[0].Id != *check.Id || *updated.Scorecard.Checks[1].Id == *check.Id {
+		t.Errorf("Expected the existing check to keep its ID and the new one to get a new ID")
+	}
+
+	scorecard["checks"].([]map[string]interface{})[0]["id"] = "unknown"
+	if _, err := client.UpdateScorecard(ctx, scorecard); !dxapi.IsValidation(err) {
+		t.Errorf("Expected a validation error for an unknown check ID, got: %v", err)
+	}
+}
+
+func TestEntityRelationshipsFollowTheirRelation(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestClient(t, Token)
+
+	if _, err := client.CreateRelation(ctx, map[string]interface{}{
+		"identifier": "runs_on", "type": "depends on", "cardinality": "many_to_one",
+		"source_entity_type_identifier": "service", "target_entity_type_identifier": "service",
+	}); err != nil {
+		t.Fatalf("CreateRelation failed: %s", err)
+	}
+	for _, identifier := range []string{"checkout", "cluster-a", "cluster-b"} {
+		if _, err := client.CreateEntity(ctx, map[string]interface{}{"identifier": identifier, "type": "service"}); err != nil {
+			t.Fatalf("CreateEntity failed: %s", err)
+		}
+	}
+
+	edge := func(target string) map[string]interface{} {
+		return map[string]interface{}{"relation_identifier": "runs_on", "source_entity_identifier": "checkout", "target_entity_identifier": target}
+	}
+	if _, err := client.CreateEntityRelationship(ctx, edge("cluster-a")); err != nil {
+		t.Fatalf("CreateEntityRelationship failed: %s", err)
+	}
+	if _, err := client.CreateEntityRelationship(ctx, edge("cluster-b")); !dxapi.IsValidation(err) {
+		t.Errorf("Expected a validation error for a second target of a many_to_one relation, got: %v", err)
+	}
+
+	if _, err := client.DeleteEntity(ctx, "cluster-a"); err != nil {
+		t.Fatalf("DeleteEntity failed: %s", err)
+	}
+	if _, err := client.GetEntityRelationship(ctx, "runs_on", "checkout", "cluster-a"); !dxapi.IsNotFound(err) {
+		t.Errorf("Expected relationships of a deleted entity to be deleted, got: %v", err)
+	}
+}
+
+func TestExemptionsExpireAndFollowTheirCheck(t *testing.T) {
+	ctx := context.Background()
+	client, fake := newTestClient(t, Token)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake.now = func() time.Time { return now }
+
+	if _, err := client.CreateEntity(ctx, map[string]interface{}{"identifier": "checkout", "type": "service"}); err != nil {
+		t.Fatalf("CreateEntity failed: %s", err)
+	}
+	sc, err := client.CreateScorecard(ctx, map[string]interface{}{
+		"name":                           "Production readiness",
+		"type":                           "LEVEL",
+		"entity_filter_type":             "entity_types",
+		"entity_filter_type_identifiers": []string{"service"},
+		"evaluation_frequency_hours":     2,
+		"empty_level_label":              "None",
+		"empty_level_color":              "#cccccc",
+		"levels":                         []map[string]interface{}{{"key": "bronze", "name": "Bronze", "color": "#cd7f32", "rank": 1}},
+		"checks": []map[string]interface{}{{
+			"name": "Has runbook", "sql": "select 'FAIL' as status", "ordering": 0, "scorecard_level_key": "bronze",
+		}},
+	})
+	if err != nil {
+		t.Fatalf("CreateScorecard failed: %s", err)
+	}
+	checkId := *sc.Scorecard.Checks[0].Id
+
+	created, err := client.CreateExemption(ctx, map[string]interface{}{
+		"scorecard_id": sc.Scorecard.Id, "check_id": checkId, "entity_identifier": "checkout",
+		"reason": "Legacy", "expires_at": "2026-01-02T02:00:00+02:00",
+	})
+	if err != nil {
+		t.Fatalf("CreateExemption failed: %s", err)
+	}
+	if *created.Exemption.ExpiresAt != "2026-01-02T00:00:00Z" {
+		t.Errorf("Expected the expiry in UTC, got %s", *created.Exemption.ExpiresAt)
+	}
+
+	listed := func() int {
+		count := 0
+		for _, err := range client.IterateExemptions(ctx, &dxapi.ListExemptionsOptions{ScorecardId: sc.Scorecard.Id}) {
+			if err != nil {
+				t.Fatalf("IterateExemptions failed: %s", err)
+			}
+			count++
+		}
+		return count
+	}
+	if listed() != 1 {
+		t.Errorf("Expected the active exemption to be listed")
+	}
+
+	now = now.Add(48 * time.Hour)
+	if listed() != 0 {
+		t.Errorf("Expected the expired exemption not to be listed")
+	}
+	if _, err := client.GetExemption(ctx, created.Exemption.Id); err != nil {
+		t.Errorf("Expected the expired exemption to still be readable, got: %v", err)
+	}
+
+	if _, err := client.DeleteScorecardCheck(ctx, sc.Scorecard.Id, checkId); err != nil {
+		t.Fatalf("DeleteScorecardCheck failed: %s", err)
+	}
+	if _, err := client.GetExemption(ctx, created.Exemption.Id); !dxapi.IsNotFound(err) {
+		t.Errorf("Expected exemptions of a deleted check to be deleted, got: %v", err)
+	}
+}
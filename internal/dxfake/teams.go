@@ -0,0 +1,39 @@
+package dxfake
+
+func (s *Server) listTeams(req *request) (interface{}, *apiError) {
+	limit, offset, err := pageParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	all := []Team{}
+	for _, id := range sortedKeys(s.teams) {
+		all = append(all, s.teams[id])
+	}
+
+	page, nextCursor := paginate(all, limit, offset)
+	return map[string]interface{}{
+		"ok":                true,
+		"teams":             page,
+		"response_metadata": map[string]string{"next_cursor": nextCursor},
+	}, nil
+}
+
+func (s *Server) listUsers(req *request) (interface{}, *apiError) {
+	limit, offset, err := pageParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	all := []User{}
+	for _, id := range sortedKeys(s.users) {
+		all = append(all, s.users[id])
+	}
+
+	page, nextCursor := paginate(all, limit, offset)
+	return map[string]interface{}{
+		"ok":                true,
+		"users":             page,
+		"response_metadata": map[string]string{"next_cursor": nextCursor},
+	}, nil
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"terraform-provider-dx/dx/dxapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func defaultClientSettings() clientSettings {
+	return clientSettings{
+		retry: dxapi.RetryConfig{
+			MaxRetries: dxapi.DefaultMaxRetries,
+			MinWait:    dxapi.DefaultMinRetryWait,
+			MaxWait:    dxapi.DefaultMaxRetryWait,
+		},
+		timeout: dxapi.DefaultRequestTimeout,
+		headers: map[string]string{},
+	}
+}
+
+// TestNewClientSettingsUnknown verifies that settings that are unknown until apply keep their
+// defaults instead of failing the configuration or turning into zero values.
+func TestNewClientSettingsUnknown(t *testing.T) {
+	tests := map[string]func(*DxProviderModel){
+		"not set":                 func(m *DxProviderModel) {},
+		"max_retries":             func(m *DxProviderModel) { m.MaxRetries = types.Int64Unknown() },
+		"max_retry_wait_seconds":  func(m *DxProviderModel) { m.MaxRetryWaitSeconds = types.Int64Unknown() },
+		"max_requests_per_second": func(m *DxProviderModel) { m.MaxRequestsPerSecond = types.Int64Unknown() },
+		"max_concurrent_requests": func(m *DxProviderModel) { m.MaxConcurrentRequests = types.Int64Unknown() },
+		"request_timeout":         func(m *DxProviderModel) { m.RequestTimeout = types.StringUnknown() },
+		"custom_headers":          func(m *DxProviderModel) { m.CustomHeaders = types.MapUnknown(types.StringType) },
+		"custom_headers element": func(m *DxProviderModel) {
+			m.CustomHeaders = types.MapValueMust(types.StringType, map[string]attr.Value{"X-Team": types.StringUnknown()})
+		},
+		"log_redacted_keys": func(m *DxProviderModel) { m.LogRedactedKeys = types.ListUnknown(types.StringType) },
+		"log_redacted_keys element": func(m *DxProviderModel) {
+			m.LogRedactedKeys = types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()})
+		},
+	}
+
+	for name, setUnknown := range tests {
+		t.Run(name, func(t *testing.T) {
+			var config DxProviderModel
+			setUnknown(&config)
+
+			settings, diags := newClientSettings(context.Background(), config)
+
+			if diags.HasError() {
+				t.Fatalf("Expected no errors, got: %v", diags)
+			}
+			if want := defaultClientSettings(); !reflect.DeepEqual(settings, want) {
+				t.Errorf("Expected the default settings %+v, got %+v", want, settings)
+			}
+		})
+	}
+}
+
+func TestNewClientSettings(t *testing.T) {
+	config := DxProviderModel{
+		MaxRetries:            types.Int64Value(2),
+		MaxRetryWaitSeconds:   types.Int64Value(10),
+		MaxRequestsPerSecond:  types.Int64Value(5),
+		MaxConcurrentRequests: types.Int64Value(3),
+		RequestTimeout:        types.StringValue("2m"),
+		CustomHeaders:         types.MapValueMust(types.StringType, map[string]attr.Value{"X-Team": types.StringValue("platform")}),
+		LogRedactedKeys:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("properties")}),
+	}
+
+	settings, diags := newClientSettings(context.Background(), config)
+
+	if diags.HasError() {
+		t.Fatalf("Expected no errors, got: %v", diags)
+	}
+	want := clientSettings{
+		retry: dxapi.RetryConfig{
+			MaxRetries: 2,
+			MinWait:    dxapi.DefaultMinRetryWait,
+			MaxWait:    10 * time.Second,
+		},
+		requestsPerSecond:     5,
+		maxConcurrentRequests: 3,
+		timeout:               2 * time.Minute,
+		headers:               map[string]string{"X-Team": "platform"},
+		redactedKeys:          []string{"properties"},
+	}
+	if !reflect.DeepEqual(settings, want) {
+		t.Errorf("Expected %+v, got %+v", want, settings)
+	}
+}
+
+func TestNewClientSettingsInvalidTimeout(t *testing.T) {
+	config := DxProviderModel{RequestTimeout: types.StringValue("soon")}
+
+	_, diags := newClientSettings(context.Background(), config)
+
+	if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != "Invalid request timeout" {
+		t.Errorf("Expected an invalid request timeout error, got: %v", diags)
+	}
+}